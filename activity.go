@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -81,6 +82,11 @@ type ActivityAllParameters struct {
 
 // All allows you to get all the project activities.
 func (s Activity) All(options ...ActivityAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the project activities.
+func (s Activity) AllWithContext(ctx context.Context, options ...ActivityAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewActivity() method to create a new Activity object")
 	}
@@ -119,9 +125,10 @@ func (s Activity) All(options ...ActivityAllParameters) (*Iterator, error) {
 
 	path := "/activities"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -129,23 +136,30 @@ func (s Activity) All(options ...ActivityAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Activity",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -154,31 +168,52 @@ func (s Activity) All(options ...ActivityAllParameters) (*Iterator, error) {
 	for _, o := range payload.Activities {
 		activitiesList = append(activitiesList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	activitiesIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Activity",
+			Method:   "All",
+		},
 		data:    activitiesList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"activities"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Activity `json:"activities"`
+				HasMore bool        `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return activitiesIterator, nil
 }
 
+// AllTyped allows you to get all the project activities, through an iterator of *Activity.
+func (s Activity) AllTyped(options ...ActivityAllParameters) (*TypedIterator[*Activity], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the project activities, through an iterator of *Activity.
+func (s Activity) AllTypedWithContext(ctx context.Context, options ...ActivityAllParameters) (*TypedIterator[*Activity], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Activity]{Iterator: it}, nil
+}
+
 // ActivityFindParameters is the structure representing the
 // additional parameters used to call Activity.Find
 type ActivityFindParameters struct {
@@ -188,6 +223,11 @@ type ActivityFindParameters struct {
 
 // Find allows you to find a specific activity and fetch its data.
 func (s Activity) Find(activityID string, options ...ActivityFindParameters) (*Activity, error) {
+	return s.FindWithContext(context.Background(), activityID, options...)
+}
+
+// FindWithContext allows you to find a specific activity and fetch its data.
+func (s Activity) FindWithContext(ctx context.Context, activityID string, options ...ActivityFindParameters) (*Activity, error) {
 	if s.client == nil {
 		panic("Please use the client.NewActivity() method to create a new Activity object")
 	}
@@ -225,9 +265,10 @@ func (s Activity) Find(activityID string, options ...ActivityFindParameters) (*A
 
 	path := "/activities/" + url.QueryEscape(activityID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -235,23 +276,31 @@ func (s Activity) Find(activityID string, options ...ActivityFindParameters) (*A
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Activity",
+		Method:     "Find",
+		ResourceID: activityID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -108,6 +109,11 @@ type AddonFetchSubscriptionAddonsParameters struct {
 
 // FetchSubscriptionAddons allows you to get the addons applied to the subscription.
 func (s Addon) FetchSubscriptionAddons(subscriptionID string, options ...AddonFetchSubscriptionAddonsParameters) (*Iterator, error) {
+	return s.FetchSubscriptionAddonsWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FetchSubscriptionAddonsWithContext allows you to get the addons applied to the subscription.
+func (s Addon) FetchSubscriptionAddonsWithContext(ctx context.Context, subscriptionID string, options ...AddonFetchSubscriptionAddonsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewAddon() method to create a new Addon object")
 	}
@@ -146,9 +152,10 @@ func (s Addon) FetchSubscriptionAddons(subscriptionID string, options ...AddonFe
 
 	path := "/subscriptions/" + url.QueryEscape(subscriptionID) + "/addons"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -156,23 +163,30 @@ func (s Addon) FetchSubscriptionAddons(subscriptionID string, options ...AddonFe
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Addon",
+		Method:   "FetchSubscriptionAddons",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -181,31 +195,52 @@ func (s Addon) FetchSubscriptionAddons(subscriptionID string, options ...AddonFe
 	for _, o := range payload.Addons {
 		addonsList = append(addonsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	addonsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Addon",
+			Method:   "FetchSubscriptionAddons",
+		},
 		data:    addonsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"addons"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Addon `json:"addons"`
+				HasMore bool     `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return addonsIterator, nil
 }
 
+// FetchSubscriptionAddonsTyped allows you to get the addons applied to the subscription, through an iterator of *Addon.
+func (s Addon) FetchSubscriptionAddonsTyped(subscriptionID string, options ...AddonFetchSubscriptionAddonsParameters) (*TypedIterator[*Addon], error) {
+	return s.FetchSubscriptionAddonsTypedWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FetchSubscriptionAddonsTypedWithContext allows you to get the addons applied to the subscription, through an iterator of *Addon.
+func (s Addon) FetchSubscriptionAddonsTypedWithContext(ctx context.Context, subscriptionID string, options ...AddonFetchSubscriptionAddonsParameters) (*TypedIterator[*Addon], error) {
+	it, err := s.FetchSubscriptionAddonsWithContext(ctx, subscriptionID, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Addon]{Iterator: it}, nil
+}
+
 // AddonCreateParameters is the structure representing the
 // additional parameters used to call Addon.Create
 type AddonCreateParameters struct {
@@ -218,6 +253,11 @@ type AddonCreateParameters struct {
 
 // Create allows you to create a new addon to the given subscription ID.
 func (s Addon) Create(options ...AddonCreateParameters) (*Addon, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new addon to the given subscription ID.
+func (s Addon) CreateWithContext(ctx context.Context, options ...AddonCreateParameters) (*Addon, error) {
 	if s.client == nil {
 		panic("Please use the client.NewAddon() method to create a new Addon object")
 	}
@@ -233,6 +273,13 @@ func (s Addon) Create(options ...AddonCreateParameters) (*Addon, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Addon)
+	if err := s.client.validate(Call{
+		Resource: "Addon",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Addon   *Addon `json:"addon"`
@@ -273,9 +320,10 @@ func (s Addon) Create(options ...AddonCreateParameters) (*Addon, error) {
 
 	path := "/subscriptions/" + url.QueryEscape(*s.SubscriptionID) + "/addons"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -283,23 +331,30 @@ func (s Addon) Create(options ...AddonCreateParameters) (*Addon, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Addon",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -317,6 +372,11 @@ type AddonFindParameters struct {
 
 // Find allows you to find a subscription's addon by its ID.
 func (s Addon) Find(subscriptionID, addonID string, options ...AddonFindParameters) (*Addon, error) {
+	return s.FindWithContext(context.Background(), subscriptionID, addonID, options...)
+}
+
+// FindWithContext allows you to find a subscription's addon by its ID.
+func (s Addon) FindWithContext(ctx context.Context, subscriptionID, addonID string, options ...AddonFindParameters) (*Addon, error) {
 	if s.client == nil {
 		panic("Please use the client.NewAddon() method to create a new Addon object")
 	}
@@ -354,9 +414,10 @@ func (s Addon) Find(subscriptionID, addonID string, options ...AddonFindParamete
 
 	path := "/subscriptions/" + url.QueryEscape(subscriptionID) + "/addons/" + url.QueryEscape(addonID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -364,23 +425,31 @@ func (s Addon) Find(subscriptionID, addonID string, options ...AddonFindParamete
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Addon",
+		Method:     "Find",
+		ResourceID: addonID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -402,6 +471,11 @@ type AddonSaveParameters struct {
 
 // Save allows you to save the updated addon attributes.
 func (s Addon) Save(options ...AddonSaveParameters) (*Addon, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated addon attributes.
+func (s Addon) SaveWithContext(ctx context.Context, options ...AddonSaveParameters) (*Addon, error) {
 	if s.client == nil {
 		panic("Please use the client.NewAddon() method to create a new Addon object")
 	}
@@ -417,6 +491,14 @@ func (s Addon) Save(options ...AddonSaveParameters) (*Addon, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Addon)
+	if err := s.client.validate(Call{
+		Resource:   "Addon",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Addon   *Addon `json:"addon"`
@@ -459,9 +541,10 @@ func (s Addon) Save(options ...AddonSaveParameters) (*Addon, error) {
 
 	path := "/subscriptions/" + url.QueryEscape(*s.SubscriptionID) + "/addons/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -469,23 +552,31 @@ func (s Addon) Save(options ...AddonSaveParameters) (*Addon, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Addon",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -506,6 +597,11 @@ type AddonDeleteParameters struct {
 
 // Delete allows you to delete an addon applied to a subscription.
 func (s Addon) Delete(options ...AddonDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete an addon applied to a subscription.
+func (s Addon) DeleteWithContext(ctx context.Context, options ...AddonDeleteParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewAddon() method to create a new Addon object")
 	}
@@ -548,9 +644,10 @@ func (s Addon) Delete(options ...AddonDeleteParameters) error {
 
 	path := "/subscriptions/" + url.QueryEscape(*s.SubscriptionID) + "/addons/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -558,23 +655,31 @@ func (s Addon) Delete(options ...AddonDeleteParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Addon",
+		Method:     "Delete",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -582,6 +687,17 @@ func (s Addon) Delete(options ...AddonDeleteParameters) error {
 	return nil
 }
 
+// AmountMoney returns the amount of the addon in the currency of its
+// subscription, which is empty unless Subscription was expanded
+func (s *Addon) AmountMoney() (Money, error) {
+	var currency *string
+	if s.Subscription != nil {
+		currency = s.Subscription.Currency
+	}
+
+	return moneyOf(s.Amount, currency)
+}
+
 // dummyAddon is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -105,6 +106,11 @@ type APIRequestAllParameters struct {
 
 // All allows you to get all the API requests.
 func (s APIRequest) All(options ...APIRequestAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the API requests.
+func (s APIRequest) AllWithContext(ctx context.Context, options ...APIRequestAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewAPIRequest() method to create a new APIRequest object")
 	}
@@ -143,9 +149,10 @@ func (s APIRequest) All(options ...APIRequestAllParameters) (*Iterator, error) {
 
 	path := "/api-requests"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -153,23 +160,30 @@ func (s APIRequest) All(options ...APIRequestAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "APIRequest",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -178,31 +192,52 @@ func (s APIRequest) All(options ...APIRequestAllParameters) (*Iterator, error) {
 	for _, o := range payload.ApiRequests {
 		APIRequestsList = append(APIRequestsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	APIRequestsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "APIRequest",
+			Method:   "All",
+		},
 		data:    APIRequestsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"api_requests"`
-				HasMore bool            `json:"has_more"`
+				Data    []*APIRequest `json:"api_requests"`
+				HasMore bool          `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return APIRequestsIterator, nil
 }
 
+// AllTyped allows you to get all the API requests, through an iterator of *APIRequest.
+func (s APIRequest) AllTyped(options ...APIRequestAllParameters) (*TypedIterator[*APIRequest], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the API requests, through an iterator of *APIRequest.
+func (s APIRequest) AllTypedWithContext(ctx context.Context, options ...APIRequestAllParameters) (*TypedIterator[*APIRequest], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*APIRequest]{Iterator: it}, nil
+}
+
 // APIRequestFindParameters is the structure representing the
 // additional parameters used to call APIRequest.Find
 type APIRequestFindParameters struct {
@@ -212,6 +247,11 @@ type APIRequestFindParameters struct {
 
 // Find allows you to find an API request by its ID.
 func (s APIRequest) Find(APIRequestID string, options ...APIRequestFindParameters) (*APIRequest, error) {
+	return s.FindWithContext(context.Background(), APIRequestID, options...)
+}
+
+// FindWithContext allows you to find an API request by its ID.
+func (s APIRequest) FindWithContext(ctx context.Context, APIRequestID string, options ...APIRequestFindParameters) (*APIRequest, error) {
 	if s.client == nil {
 		panic("Please use the client.NewAPIRequest() method to create a new APIRequest object")
 	}
@@ -249,9 +289,10 @@ func (s APIRequest) Find(APIRequestID string, options ...APIRequestFindParameter
 
 	path := "/api-requests/{request_id}"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -259,23 +300,30 @@ func (s APIRequest) Find(APIRequestID string, options ...APIRequestFindParameter
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "APIRequest",
+		Method:   "Find",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -156,6 +157,11 @@ type CardAllParameters struct {
 
 // All allows you to get all the cards.
 func (s Card) All(options ...CardAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the cards.
+func (s Card) AllWithContext(ctx context.Context, options ...CardAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCard() method to create a new Card object")
 	}
@@ -194,9 +200,10 @@ func (s Card) All(options ...CardAllParameters) (*Iterator, error) {
 
 	path := "/cards"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -204,23 +211,30 @@ func (s Card) All(options ...CardAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Card",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -229,31 +243,52 @@ func (s Card) All(options ...CardAllParameters) (*Iterator, error) {
 	for _, o := range payload.Cards {
 		cardsList = append(cardsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	cardsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Card",
+			Method:   "All",
+		},
 		data:    cardsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"cards"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Card `json:"cards"`
+				HasMore bool    `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return cardsIterator, nil
 }
 
+// AllTyped allows you to get all the cards, through an iterator of *Card.
+func (s Card) AllTyped(options ...CardAllParameters) (*TypedIterator[*Card], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the cards, through an iterator of *Card.
+func (s Card) AllTypedWithContext(ctx context.Context, options ...CardAllParameters) (*TypedIterator[*Card], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Card]{Iterator: it}, nil
+}
+
 // CardFindParameters is the structure representing the
 // additional parameters used to call Card.Find
 type CardFindParameters struct {
@@ -263,6 +298,11 @@ type CardFindParameters struct {
 
 // Find allows you to find a card by its ID.
 func (s Card) Find(cardID string, options ...CardFindParameters) (*Card, error) {
+	return s.FindWithContext(context.Background(), cardID, options...)
+}
+
+// FindWithContext allows you to find a card by its ID.
+func (s Card) FindWithContext(ctx context.Context, cardID string, options ...CardFindParameters) (*Card, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCard() method to create a new Card object")
 	}
@@ -300,9 +340,10 @@ func (s Card) Find(cardID string, options ...CardFindParameters) (*Card, error)
 
 	path := "/cards/" + url.QueryEscape(cardID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -310,23 +351,31 @@ func (s Card) Find(cardID string, options ...CardFindParameters) (*Card, error)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Card",
+		Method:     "Find",
+		ResourceID: cardID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -344,6 +393,11 @@ type CardAnonymizeParameters struct {
 
 // Anonymize allows you to anonymize the card.
 func (s Card) Anonymize(options ...CardAnonymizeParameters) error {
+	return s.AnonymizeWithContext(context.Background(), options...)
+}
+
+// AnonymizeWithContext allows you to anonymize the card.
+func (s Card) AnonymizeWithContext(ctx context.Context, options ...CardAnonymizeParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewCard() method to create a new Card object")
 	}
@@ -380,9 +434,10 @@ func (s Card) Anonymize(options ...CardAnonymizeParameters) error {
 
 	path := "/cards/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -390,23 +445,31 @@ func (s Card) Anonymize(options ...CardAnonymizeParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Card",
+		Method:     "Anonymize",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
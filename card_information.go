@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -69,6 +70,11 @@ type CardInformationFetchParameters struct {
 
 // Fetch allows you to fetch card information from the IIN.
 func (s CardInformation) Fetch(iin string, options ...CardInformationFetchParameters) (*CardInformation, error) {
+	return s.FetchWithContext(context.Background(), iin, options...)
+}
+
+// FetchWithContext allows you to fetch card information from the IIN.
+func (s CardInformation) FetchWithContext(ctx context.Context, iin string, options ...CardInformationFetchParameters) (*CardInformation, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCardInformation() method to create a new CardInformation object")
 	}
@@ -106,9 +112,10 @@ func (s CardInformation) Fetch(iin string, options ...CardInformationFetchParame
 
 	path := "/iins/" + url.QueryEscape(iin) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -116,23 +123,31 @@ func (s CardInformation) Fetch(iin string, options ...CardInformationFetchParame
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "CardInformation",
+		Method:     "Fetch",
+		ResourceID: iin,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
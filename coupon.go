@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -99,6 +100,11 @@ type CouponAllParameters struct {
 
 // All allows you to get all the coupons.
 func (s Coupon) All(options ...CouponAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the coupons.
+func (s Coupon) AllWithContext(ctx context.Context, options ...CouponAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCoupon() method to create a new Coupon object")
 	}
@@ -137,9 +143,10 @@ func (s Coupon) All(options ...CouponAllParameters) (*Iterator, error) {
 
 	path := "/coupons"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -147,23 +154,30 @@ func (s Coupon) All(options ...CouponAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Coupon",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -172,31 +186,52 @@ func (s Coupon) All(options ...CouponAllParameters) (*Iterator, error) {
 	for _, o := range payload.Coupons {
 		couponsList = append(couponsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	couponsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Coupon",
+			Method:   "All",
+		},
 		data:    couponsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"coupons"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Coupon `json:"coupons"`
+				HasMore bool      `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return couponsIterator, nil
 }
 
+// AllTyped allows you to get all the coupons, through an iterator of *Coupon.
+func (s Coupon) AllTyped(options ...CouponAllParameters) (*TypedIterator[*Coupon], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the coupons, through an iterator of *Coupon.
+func (s Coupon) AllTypedWithContext(ctx context.Context, options ...CouponAllParameters) (*TypedIterator[*Coupon], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Coupon]{Iterator: it}, nil
+}
+
 // CouponCreateParameters is the structure representing the
 // additional parameters used to call Coupon.Create
 type CouponCreateParameters struct {
@@ -206,6 +241,11 @@ type CouponCreateParameters struct {
 
 // Create allows you to create a new coupon.
 func (s Coupon) Create(options ...CouponCreateParameters) (*Coupon, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new coupon.
+func (s Coupon) CreateWithContext(ctx context.Context, options ...CouponCreateParameters) (*Coupon, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCoupon() method to create a new Coupon object")
 	}
@@ -221,6 +261,13 @@ func (s Coupon) Create(options ...CouponCreateParameters) (*Coupon, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Coupon)
+	if err := s.client.validate(Call{
+		Resource: "Coupon",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Coupon  *Coupon `json:"coupon"`
@@ -259,9 +306,10 @@ func (s Coupon) Create(options ...CouponCreateParameters) (*Coupon, error) {
 
 	path := "/coupons"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -269,23 +317,30 @@ func (s Coupon) Create(options ...CouponCreateParameters) (*Coupon, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Coupon",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -303,6 +358,11 @@ type CouponFindParameters struct {
 
 // Find allows you to find a coupon by its ID.
 func (s Coupon) Find(couponID string, options ...CouponFindParameters) (*Coupon, error) {
+	return s.FindWithContext(context.Background(), couponID, options...)
+}
+
+// FindWithContext allows you to find a coupon by its ID.
+func (s Coupon) FindWithContext(ctx context.Context, couponID string, options ...CouponFindParameters) (*Coupon, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCoupon() method to create a new Coupon object")
 	}
@@ -340,9 +400,10 @@ func (s Coupon) Find(couponID string, options ...CouponFindParameters) (*Coupon,
 
 	path := "/coupons/" + url.QueryEscape(couponID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -350,23 +411,31 @@ func (s Coupon) Find(couponID string, options ...CouponFindParameters) (*Coupon,
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Coupon",
+		Method:     "Find",
+		ResourceID: couponID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -384,6 +453,11 @@ type CouponSaveParameters struct {
 
 // Save allows you to save the updated coupon attributes.
 func (s Coupon) Save(options ...CouponSaveParameters) (*Coupon, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated coupon attributes.
+func (s Coupon) SaveWithContext(ctx context.Context, options ...CouponSaveParameters) (*Coupon, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCoupon() method to create a new Coupon object")
 	}
@@ -399,6 +473,14 @@ func (s Coupon) Save(options ...CouponSaveParameters) (*Coupon, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Coupon)
+	if err := s.client.validate(Call{
+		Resource:   "Coupon",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Coupon  *Coupon `json:"coupon"`
@@ -423,9 +505,10 @@ func (s Coupon) Save(options ...CouponSaveParameters) (*Coupon, error) {
 
 	path := "/coupons/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -433,23 +516,31 @@ func (s Coupon) Save(options ...CouponSaveParameters) (*Coupon, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Coupon",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -467,6 +558,11 @@ type CouponDeleteParameters struct {
 
 // Delete allows you to delete the coupon.
 func (s Coupon) Delete(options ...CouponDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete the coupon.
+func (s Coupon) DeleteWithContext(ctx context.Context, options ...CouponDeleteParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewCoupon() method to create a new Coupon object")
 	}
@@ -503,9 +599,10 @@ func (s Coupon) Delete(options ...CouponDeleteParameters) error {
 
 	path := "/coupons/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -513,23 +610,31 @@ func (s Coupon) Delete(options ...CouponDeleteParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Coupon",
+		Method:     "Delete",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -537,6 +642,17 @@ func (s Coupon) Delete(options ...CouponDeleteParameters) error {
 	return nil
 }
 
+// AmountOffMoney returns the amount off of the coupon in its currency
+func (s *Coupon) AmountOffMoney() (Money, error) {
+	return moneyOf(s.AmountOff, s.Currency)
+}
+
+// Validate returns a validation error if the currency of the coupon isn't
+// an ISO 4217 currency. It implements Validator
+func (s *Coupon) Validate() error {
+	return validateCurrency("currency", s.Currency)
+}
+
 // dummyCoupon is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
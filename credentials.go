@@ -0,0 +1,191 @@
+package processout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/processout.v4/errors"
+)
+
+// Default environment variables read by EnvCredentials
+const (
+	DefaultProjectIDEnv     = "PROCESSOUT_PROJECT_ID"
+	DefaultProjectSecretEnv = "PROCESSOUT_PROJECT_SECRET"
+)
+
+// Credentials are the credentials of a ProcessOut project, used to
+// authenticate the API requests
+type Credentials struct {
+	ProjectID     string
+	ProjectSecret string
+}
+
+// CredentialsProvider provides the credentials of the API requests. It is
+// called before every request so that rotated credentials are used without
+// rebuilding the client, and must be safe for concurrent use. Its errors
+// abort the request and aren't retried: ProcessOut errors are returned as
+// is by the resource methods, and other errors are wrapped in an
+// errors.Error
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// StaticCredentials is a CredentialsProvider always providing the same
+// credentials
+type StaticCredentials Credentials
+
+// Credentials implements CredentialsProvider
+func (c StaticCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	return Credentials(c), nil
+}
+
+// EnvCredentials is a CredentialsProvider reading the credentials from
+// environment variables on every request
+type EnvCredentials struct {
+	// ProjectIDVar is the variable holding the project ID.
+	// DefaultProjectIDEnv is used when empty
+	ProjectIDVar string
+	// ProjectSecretVar is the variable holding the project secret key.
+	// DefaultProjectSecretEnv is used when empty
+	ProjectSecretVar string
+}
+
+// Credentials implements CredentialsProvider
+func (e EnvCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	idVar, secretVar := e.ProjectIDVar, e.ProjectSecretVar
+	if idVar == "" {
+		idVar = DefaultProjectIDEnv
+	}
+	if secretVar == "" {
+		secretVar = DefaultProjectSecretEnv
+	}
+
+	c := Credentials{
+		ProjectID:     os.Getenv(idVar),
+		ProjectSecret: os.Getenv(secretVar),
+	}
+	if c.ProjectID == "" || c.ProjectSecret == "" {
+		return Credentials{}, errors.New(nil, "", fmt.Sprintf(
+			"The ProcessOut credentials are missing from the %s and %s environment variables", idVar, secretVar))
+	}
+	return c, nil
+}
+
+// fileStamp identifies a version of a file
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// FileCredentials is a CredentialsProvider reading the credentials from
+// files, such as the keys of a Kubernetes secret mounted as a volume. The
+// files are reloaded when they change, so that rotated credentials are
+// picked up live. Leading and trailing spaces are ignored.
+//
+// The files are checked for changes when credentials are requested, at
+// most once per Interval, so that no goroutine has to watch them. When the
+// files can't be read while being rotated, the last credentials read are
+// used until the next check
+type FileCredentials struct {
+	// Interval is the minimum delay between two checks of the files for
+	// changes. One second is used when zero
+	Interval time.Duration
+
+	paths [2]string
+
+	mu      sync.Mutex
+	creds   Credentials
+	stamps  [2]fileStamp
+	checked time.Time
+}
+
+// NewFileCredentials creates a new FileCredentials reading the project ID
+// and secret key from the given files. An error is returned if they can't
+// be read
+func NewFileCredentials(projectIDFile, projectSecretFile string) (*FileCredentials, error) {
+	f := &FileCredentials{paths: [2]string{projectIDFile, projectSecretFile}}
+	if _, err := f.reload(time.Now()); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Credentials implements CredentialsProvider
+func (f *FileCredentials) Credentials(ctx context.Context) (Credentials, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	interval := f.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	now := time.Now()
+	if now.Sub(f.checked) < interval {
+		return f.creds, nil
+	}
+
+	if _, err := f.reload(now); err != nil && f.creds.ProjectSecret == "" {
+		return Credentials{}, err
+	}
+	return f.creds, nil
+}
+
+// reload reads the files again if they changed since they were last read,
+// and returns whether or not they did. It must be called with the mutex
+// held, except when creating the provider
+func (f *FileCredentials) reload(now time.Time) (bool, error) {
+	f.checked = now
+
+	stamps := [2]fileStamp{}
+	for i, path := range f.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return false, errors.New(err, "", "")
+		}
+		stamps[i] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+	}
+	if stamps == f.stamps {
+		return false, nil
+	}
+
+	values := [2]string{}
+	for i, path := range f.paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return false, errors.New(err, "", "")
+		}
+		values[i] = strings.TrimSpace(string(b))
+		if values[i] == "" {
+			return false, errors.New(nil, "", fmt.Sprintf("The ProcessOut credentials file %s is empty", path))
+		}
+	}
+
+	f.creds = Credentials{ProjectID: values[0], ProjectSecret: values[1]}
+	f.stamps = stamps
+	return true, nil
+}
+
+// authenticate sets the credentials of the client on the request, when
+// the client has a CredentialsProvider. The static credentials given to
+// New are set by setupRequest otherwise
+func (c *ProcessOut) authenticate(req *http.Request) error {
+	if c.credentials == nil {
+		return nil
+	}
+
+	creds, err := c.credentials.Credentials(req.Context())
+	if err != nil {
+		if _, ok := err.(errors.CodedError); !ok {
+			err = errors.New(err, "", "")
+		}
+		return err
+	}
+	req.SetBasicAuth(creds.ProjectID, creds.ProjectSecret)
+	return nil
+}
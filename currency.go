@@ -0,0 +1,317 @@
+package processout
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"gopkg.in/processout.v4/errors"
+)
+
+// Currency describes an ISO 4217 currency
+type Currency struct {
+	// Code is the ISO 4217 code of the currency, such as USD
+	Code string
+	// Name is the English name of the currency
+	Name string
+	// MinorUnits is the number of decimals of the amounts in the currency,
+	// such as 2 for USD, 0 for JPY and 3 for KWD
+	MinorUnits int32
+	// Symbol is the symbol of the currency, such as $ or €. It is empty
+	// for the currencies that don't have one, such as the funds codes
+	Symbol string
+}
+
+// currencies is the catalogue of the active ISO 4217 currencies
+var currencies = map[string]Currency{
+	"AED": {Code: "AED", Name: "UAE Dirham", MinorUnits: 2, Symbol: "د.إ"},
+	"AFN": {Code: "AFN", Name: "Afghani", MinorUnits: 2, Symbol: "؋"},
+	"ALL": {Code: "ALL", Name: "Lek", MinorUnits: 2, Symbol: "L"},
+	"AMD": {Code: "AMD", Name: "Armenian Dram", MinorUnits: 2, Symbol: "֏"},
+	"ANG": {Code: "ANG", Name: "Netherlands Antillean Guilder", MinorUnits: 2, Symbol: "ƒ"},
+	"AOA": {Code: "AOA", Name: "Kwanza", MinorUnits: 2, Symbol: "Kz"},
+	"ARS": {Code: "ARS", Name: "Argentine Peso", MinorUnits: 2, Symbol: "$"},
+	"AUD": {Code: "AUD", Name: "Australian Dollar", MinorUnits: 2, Symbol: "A$"},
+	"AWG": {Code: "AWG", Name: "Aruban Florin", MinorUnits: 2, Symbol: "ƒ"},
+	"AZN": {Code: "AZN", Name: "Azerbaijan Manat", MinorUnits: 2, Symbol: "₼"},
+	"BAM": {Code: "BAM", Name: "Convertible Mark", MinorUnits: 2, Symbol: "KM"},
+	"BBD": {Code: "BBD", Name: "Barbados Dollar", MinorUnits: 2, Symbol: "$"},
+	"BDT": {Code: "BDT", Name: "Taka", MinorUnits: 2, Symbol: "৳"},
+	"BGN": {Code: "BGN", Name: "Bulgarian Lev", MinorUnits: 2, Symbol: "лв."},
+	"BHD": {Code: "BHD", Name: "Bahraini Dinar", MinorUnits: 3, Symbol: "BD"},
+	"BIF": {Code: "BIF", Name: "Burundi Franc", MinorUnits: 0, Symbol: "FBu"},
+	"BMD": {Code: "BMD", Name: "Bermudian Dollar", MinorUnits: 2, Symbol: "$"},
+	"BND": {Code: "BND", Name: "Brunei Dollar", MinorUnits: 2, Symbol: "$"},
+	"BOB": {Code: "BOB", Name: "Boliviano", MinorUnits: 2, Symbol: "Bs"},
+	"BOV": {Code: "BOV", Name: "Mvdol", MinorUnits: 2, Symbol: ""},
+	"BRL": {Code: "BRL", Name: "Brazilian Real", MinorUnits: 2, Symbol: "R$"},
+	"BSD": {Code: "BSD", Name: "Bahamian Dollar", MinorUnits: 2, Symbol: "$"},
+	"BTN": {Code: "BTN", Name: "Ngultrum", MinorUnits: 2, Symbol: "Nu."},
+	"BWP": {Code: "BWP", Name: "Pula", MinorUnits: 2, Symbol: "P"},
+	"BYN": {Code: "BYN", Name: "Belarusian Ruble", MinorUnits: 2, Symbol: "Br"},
+	"BZD": {Code: "BZD", Name: "Belize Dollar", MinorUnits: 2, Symbol: "$"},
+	"CAD": {Code: "CAD", Name: "Canadian Dollar", MinorUnits: 2, Symbol: "CA$"},
+	"CDF": {Code: "CDF", Name: "Congolese Franc", MinorUnits: 2, Symbol: "FC"},
+	"CHE": {Code: "CHE", Name: "WIR Euro", MinorUnits: 2, Symbol: ""},
+	"CHF": {Code: "CHF", Name: "Swiss Franc", MinorUnits: 2, Symbol: "CHF"},
+	"CHW": {Code: "CHW", Name: "WIR Franc", MinorUnits: 2, Symbol: ""},
+	"CLF": {Code: "CLF", Name: "Unidad de Fomento", MinorUnits: 4, Symbol: ""},
+	"CLP": {Code: "CLP", Name: "Chilean Peso", MinorUnits: 0, Symbol: "$"},
+	"CNY": {Code: "CNY", Name: "Yuan Renminbi", MinorUnits: 2, Symbol: "CN¥"},
+	"COP": {Code: "COP", Name: "Colombian Peso", MinorUnits: 2, Symbol: "$"},
+	"COU": {Code: "COU", Name: "Unidad de Valor Real", MinorUnits: 2, Symbol: ""},
+	"CRC": {Code: "CRC", Name: "Costa Rican Colon", MinorUnits: 2, Symbol: "₡"},
+	"CUP": {Code: "CUP", Name: "Cuban Peso", MinorUnits: 2, Symbol: "$"},
+	"CVE": {Code: "CVE", Name: "Cabo Verde Escudo", MinorUnits: 2, Symbol: "Esc"},
+	"CZK": {Code: "CZK", Name: "Czech Koruna", MinorUnits: 2, Symbol: "Kč"},
+	"DJF": {Code: "DJF", Name: "Djibouti Franc", MinorUnits: 0, Symbol: "Fdj"},
+	"DKK": {Code: "DKK", Name: "Danish Krone", MinorUnits: 2, Symbol: "kr."},
+	"DOP": {Code: "DOP", Name: "Dominican Peso", MinorUnits: 2, Symbol: "RD$"},
+	"DZD": {Code: "DZD", Name: "Algerian Dinar", MinorUnits: 2, Symbol: "DA"},
+	"EGP": {Code: "EGP", Name: "Egyptian Pound", MinorUnits: 2, Symbol: "E£"},
+	"ERN": {Code: "ERN", Name: "Nakfa", MinorUnits: 2, Symbol: "Nfk"},
+	"ETB": {Code: "ETB", Name: "Ethiopian Birr", MinorUnits: 2, Symbol: "Br"},
+	"EUR": {Code: "EUR", Name: "Euro", MinorUnits: 2, Symbol: "€"},
+	"FJD": {Code: "FJD", Name: "Fiji Dollar", MinorUnits: 2, Symbol: "$"},
+	"FKP": {Code: "FKP", Name: "Falkland Islands Pound", MinorUnits: 2, Symbol: "£"},
+	"GBP": {Code: "GBP", Name: "Pound Sterling", MinorUnits: 2, Symbol: "£"},
+	"GEL": {Code: "GEL", Name: "Lari", MinorUnits: 2, Symbol: "₾"},
+	"GHS": {Code: "GHS", Name: "Ghana Cedi", MinorUnits: 2, Symbol: "GH₵"},
+	"GIP": {Code: "GIP", Name: "Gibraltar Pound", MinorUnits: 2, Symbol: "£"},
+	"GMD": {Code: "GMD", Name: "Dalasi", MinorUnits: 2, Symbol: "D"},
+	"GNF": {Code: "GNF", Name: "Guinean Franc", MinorUnits: 0, Symbol: "FG"},
+	"GTQ": {Code: "GTQ", Name: "Quetzal", MinorUnits: 2, Symbol: "Q"},
+	"GYD": {Code: "GYD", Name: "Guyana Dollar", MinorUnits: 2, Symbol: "$"},
+	"HKD": {Code: "HKD", Name: "Hong Kong Dollar", MinorUnits: 2, Symbol: "HK$"},
+	"HNL": {Code: "HNL", Name: "Lempira", MinorUnits: 2, Symbol: "L"},
+	"HTG": {Code: "HTG", Name: "Gourde", MinorUnits: 2, Symbol: "G"},
+	"HUF": {Code: "HUF", Name: "Forint", MinorUnits: 2, Symbol: "Ft"},
+	"IDR": {Code: "IDR", Name: "Rupiah", MinorUnits: 2, Symbol: "Rp"},
+	"ILS": {Code: "ILS", Name: "New Israeli Sheqel", MinorUnits: 2, Symbol: "₪"},
+	"INR": {Code: "INR", Name: "Indian Rupee", MinorUnits: 2, Symbol: "₹"},
+	"IQD": {Code: "IQD", Name: "Iraqi Dinar", MinorUnits: 3, Symbol: "ع.د"},
+	"IRR": {Code: "IRR", Name: "Iranian Rial", MinorUnits: 2, Symbol: "﷼"},
+	"ISK": {Code: "ISK", Name: "Iceland Krona", MinorUnits: 0, Symbol: "kr"},
+	"JMD": {Code: "JMD", Name: "Jamaican Dollar", MinorUnits: 2, Symbol: "$"},
+	"JOD": {Code: "JOD", Name: "Jordanian Dinar", MinorUnits: 3, Symbol: "JD"},
+	"JPY": {Code: "JPY", Name: "Yen", MinorUnits: 0, Symbol: "¥"},
+	"KES": {Code: "KES", Name: "Kenyan Shilling", MinorUnits: 2, Symbol: "KSh"},
+	"KGS": {Code: "KGS", Name: "Som", MinorUnits: 2, Symbol: "сом"},
+	"KHR": {Code: "KHR", Name: "Riel", MinorUnits: 2, Symbol: "៛"},
+	"KMF": {Code: "KMF", Name: "Comorian Franc", MinorUnits: 0, Symbol: "CF"},
+	"KPW": {Code: "KPW", Name: "North Korean Won", MinorUnits: 2, Symbol: "₩"},
+	"KRW": {Code: "KRW", Name: "Won", MinorUnits: 0, Symbol: "₩"},
+	"KWD": {Code: "KWD", Name: "Kuwaiti Dinar", MinorUnits: 3, Symbol: "KD"},
+	"KYD": {Code: "KYD", Name: "Cayman Islands Dollar", MinorUnits: 2, Symbol: "$"},
+	"KZT": {Code: "KZT", Name: "Tenge", MinorUnits: 2, Symbol: "₸"},
+	"LAK": {Code: "LAK", Name: "Lao Kip", MinorUnits: 2, Symbol: "₭"},
+	"LBP": {Code: "LBP", Name: "Lebanese Pound", MinorUnits: 2, Symbol: "L£"},
+	"LKR": {Code: "LKR", Name: "Sri Lanka Rupee", MinorUnits: 2, Symbol: "Rs"},
+	"LRD": {Code: "LRD", Name: "Liberian Dollar", MinorUnits: 2, Symbol: "$"},
+	"LSL": {Code: "LSL", Name: "Loti", MinorUnits: 2, Symbol: "L"},
+	"LYD": {Code: "LYD", Name: "Libyan Dinar", MinorUnits: 3, Symbol: "LD"},
+	"MAD": {Code: "MAD", Name: "Moroccan Dirham", MinorUnits: 2, Symbol: "DH"},
+	"MDL": {Code: "MDL", Name: "Moldovan Leu", MinorUnits: 2, Symbol: "L"},
+	"MGA": {Code: "MGA", Name: "Malagasy Ariary", MinorUnits: 2, Symbol: "Ar"},
+	"MKD": {Code: "MKD", Name: "Denar", MinorUnits: 2, Symbol: "ден"},
+	"MMK": {Code: "MMK", Name: "Kyat", MinorUnits: 2, Symbol: "K"},
+	"MNT": {Code: "MNT", Name: "Tugrik", MinorUnits: 2, Symbol: "₮"},
+	"MOP": {Code: "MOP", Name: "Pataca", MinorUnits: 2, Symbol: "MOP$"},
+	"MRU": {Code: "MRU", Name: "Ouguiya", MinorUnits: 2, Symbol: "UM"},
+	"MUR": {Code: "MUR", Name: "Mauritius Rupee", MinorUnits: 2, Symbol: "Rs"},
+	"MVR": {Code: "MVR", Name: "Rufiyaa", MinorUnits: 2, Symbol: "Rf"},
+	"MWK": {Code: "MWK", Name: "Malawi Kwacha", MinorUnits: 2, Symbol: "MK"},
+	"MXN": {Code: "MXN", Name: "Mexican Peso", MinorUnits: 2, Symbol: "MX$"},
+	"MXV": {Code: "MXV", Name: "Mexican Unidad de Inversion", MinorUnits: 2, Symbol: ""},
+	"MYR": {Code: "MYR", Name: "Malaysian Ringgit", MinorUnits: 2, Symbol: "RM"},
+	"MZN": {Code: "MZN", Name: "Mozambique Metical", MinorUnits: 2, Symbol: "MT"},
+	"NAD": {Code: "NAD", Name: "Namibia Dollar", MinorUnits: 2, Symbol: "$"},
+	"NGN": {Code: "NGN", Name: "Naira", MinorUnits: 2, Symbol: "₦"},
+	"NIO": {Code: "NIO", Name: "Cordoba Oro", MinorUnits: 2, Symbol: "C$"},
+	"NOK": {Code: "NOK", Name: "Norwegian Krone", MinorUnits: 2, Symbol: "kr"},
+	"NPR": {Code: "NPR", Name: "Nepalese Rupee", MinorUnits: 2, Symbol: "Rs"},
+	"NZD": {Code: "NZD", Name: "New Zealand Dollar", MinorUnits: 2, Symbol: "NZ$"},
+	"OMR": {Code: "OMR", Name: "Rial Omani", MinorUnits: 3, Symbol: "ر.ع."},
+	"PAB": {Code: "PAB", Name: "Balboa", MinorUnits: 2, Symbol: "B/."},
+	"PEN": {Code: "PEN", Name: "Sol", MinorUnits: 2, Symbol: "S/"},
+	"PGK": {Code: "PGK", Name: "Kina", MinorUnits: 2, Symbol: "K"},
+	"PHP": {Code: "PHP", Name: "Philippine Peso", MinorUnits: 2, Symbol: "₱"},
+	"PKR": {Code: "PKR", Name: "Pakistan Rupee", MinorUnits: 2, Symbol: "Rs"},
+	"PLN": {Code: "PLN", Name: "Zloty", MinorUnits: 2, Symbol: "zł"},
+	"PYG": {Code: "PYG", Name: "Guarani", MinorUnits: 0, Symbol: "₲"},
+	"QAR": {Code: "QAR", Name: "Qatari Rial", MinorUnits: 2, Symbol: "QR"},
+	"RON": {Code: "RON", Name: "Romanian Leu", MinorUnits: 2, Symbol: "lei"},
+	"RSD": {Code: "RSD", Name: "Serbian Dinar", MinorUnits: 2, Symbol: "дин."},
+	"RUB": {Code: "RUB", Name: "Russian Ruble", MinorUnits: 2, Symbol: "₽"},
+	"RWF": {Code: "RWF", Name: "Rwanda Franc", MinorUnits: 0, Symbol: "RF"},
+	"SAR": {Code: "SAR", Name: "Saudi Riyal", MinorUnits: 2, Symbol: "SR"},
+	"SBD": {Code: "SBD", Name: "Solomon Islands Dollar", MinorUnits: 2, Symbol: "$"},
+	"SCR": {Code: "SCR", Name: "Seychelles Rupee", MinorUnits: 2, Symbol: "Rs"},
+	"SDG": {Code: "SDG", Name: "Sudanese Pound", MinorUnits: 2, Symbol: ""},
+	"SEK": {Code: "SEK", Name: "Swedish Krona", MinorUnits: 2, Symbol: "kr"},
+	"SGD": {Code: "SGD", Name: "Singapore Dollar", MinorUnits: 2, Symbol: "S$"},
+	"SHP": {Code: "SHP", Name: "Saint Helena Pound", MinorUnits: 2, Symbol: "£"},
+	"SLE": {Code: "SLE", Name: "Leone", MinorUnits: 2, Symbol: "Le"},
+	"SOS": {Code: "SOS", Name: "Somali Shilling", MinorUnits: 2, Symbol: "Sh"},
+	"SRD": {Code: "SRD", Name: "Surinam Dollar", MinorUnits: 2, Symbol: "$"},
+	"SSP": {Code: "SSP", Name: "South Sudanese Pound", MinorUnits: 2, Symbol: "£"},
+	"STN": {Code: "STN", Name: "Dobra", MinorUnits: 2, Symbol: "Db"},
+	"SVC": {Code: "SVC", Name: "El Salvador Colon", MinorUnits: 2, Symbol: "₡"},
+	"SYP": {Code: "SYP", Name: "Syrian Pound", MinorUnits: 2, Symbol: "£"},
+	"SZL": {Code: "SZL", Name: "Lilangeni", MinorUnits: 2, Symbol: "E"},
+	"THB": {Code: "THB", Name: "Baht", MinorUnits: 2, Symbol: "฿"},
+	"TJS": {Code: "TJS", Name: "Somoni", MinorUnits: 2, Symbol: "SM"},
+	"TMT": {Code: "TMT", Name: "Turkmenistan New Manat", MinorUnits: 2, Symbol: "m"},
+	"TND": {Code: "TND", Name: "Tunisian Dinar", MinorUnits: 3, Symbol: "DT"},
+	"TOP": {Code: "TOP", Name: "Pa’anga", MinorUnits: 2, Symbol: "T$"},
+	"TRY": {Code: "TRY", Name: "Turkish Lira", MinorUnits: 2, Symbol: "₺"},
+	"TTD": {Code: "TTD", Name: "Trinidad and Tobago Dollar", MinorUnits: 2, Symbol: "$"},
+	"TWD": {Code: "TWD", Name: "New Taiwan Dollar", MinorUnits: 2, Symbol: "NT$"},
+	"TZS": {Code: "TZS", Name: "Tanzanian Shilling", MinorUnits: 2, Symbol: "TSh"},
+	"UAH": {Code: "UAH", Name: "Hryvnia", MinorUnits: 2, Symbol: "₴"},
+	"UGX": {Code: "UGX", Name: "Uganda Shilling", MinorUnits: 0, Symbol: "USh"},
+	"USD": {Code: "USD", Name: "US Dollar", MinorUnits: 2, Symbol: "$"},
+	"USN": {Code: "USN", Name: "US Dollar (Next day)", MinorUnits: 2, Symbol: ""},
+	"UYI": {Code: "UYI", Name: "Uruguay Peso en Unidades Indexadas", MinorUnits: 0, Symbol: ""},
+	"UYU": {Code: "UYU", Name: "Peso Uruguayo", MinorUnits: 2, Symbol: "$"},
+	"UYW": {Code: "UYW", Name: "Unidad Previsional", MinorUnits: 4, Symbol: ""},
+	"UZS": {Code: "UZS", Name: "Uzbekistan Sum", MinorUnits: 2, Symbol: "soʻm"},
+	"VED": {Code: "VED", Name: "Bolívar Soberano", MinorUnits: 2, Symbol: "Bs.D"},
+	"VES": {Code: "VES", Name: "Bolívar Soberano", MinorUnits: 2, Symbol: "Bs.S"},
+	"VND": {Code: "VND", Name: "Dong", MinorUnits: 0, Symbol: "₫"},
+	"VUV": {Code: "VUV", Name: "Vatu", MinorUnits: 0, Symbol: "VT"},
+	"WST": {Code: "WST", Name: "Tala", MinorUnits: 2, Symbol: "WS$"},
+	"XAF": {Code: "XAF", Name: "CFA Franc BEAC", MinorUnits: 0, Symbol: "FCFA"},
+	"XCD": {Code: "XCD", Name: "East Caribbean Dollar", MinorUnits: 2, Symbol: "EC$"},
+	"XOF": {Code: "XOF", Name: "CFA Franc BCEAO", MinorUnits: 0, Symbol: "F CFA"},
+	"XPF": {Code: "XPF", Name: "CFP Franc", MinorUnits: 0, Symbol: "CFPF"},
+	"YER": {Code: "YER", Name: "Yemeni Rial", MinorUnits: 2, Symbol: "﷼"},
+	"ZAR": {Code: "ZAR", Name: "Rand", MinorUnits: 2, Symbol: "R"},
+	"ZMW": {Code: "ZMW", Name: "Zambian Kwacha", MinorUnits: 2, Symbol: "K"},
+	"ZWG": {Code: "ZWG", Name: "Zimbabwe Gold", MinorUnits: 2, Symbol: "ZiG"},
+}
+
+// LookupCurrency returns the ISO 4217 currency with the given code, which
+// is case insensitive, and whether or not it exists
+func LookupCurrency(code string) (Currency, bool) {
+	c, ok := currencies[strings.ToUpper(code)]
+	return c, ok
+}
+
+// Currencies returns the catalogue of the ISO 4217 currencies, sorted by
+// code
+func Currencies() []Currency {
+	l := make([]Currency, 0, len(currencies))
+	for _, c := range currencies {
+		l = append(l, c)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Code < l[j].Code
+	})
+
+	return l
+}
+
+// ValidateCurrency returns a validation error if the code isn't the one of
+// an ISO 4217 currency. The field is the name of the field of the request
+// holding the code, such as currency
+func ValidateCurrency(field, code string) error {
+	if _, ok := LookupCurrency(code); ok {
+		return nil
+	}
+
+	message := fmt.Sprintf("%q is not a valid ISO 4217 currency", code)
+	return errors.NewValidationError(errors.CodeRequestValidationError, message, errors.FieldError{
+		Field:   field,
+		Code:    errors.CodeRequestInvalidCurrency,
+		Message: message,
+	})
+}
+
+// validateCurrency validates the currency of a resource field, nil being
+// valid
+func validateCurrency(field string, code *string) error {
+	if code == nil {
+		return nil
+	}
+
+	return ValidateCurrency(field, *code)
+}
+
+// amountFromRat returns the rational number as an amount with the given
+// number of decimals, rounded half away from zero
+func amountFromRat(r *big.Rat, scale int32) Amount {
+	n := new(big.Int).Mul(r.Num(), pow10(scale))
+	q, rem := new(big.Int).QuoRem(n, r.Denom(), new(big.Int))
+	if rem.Abs(rem).Lsh(rem, 1).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+
+	return Amount{unscaled: q, scale: scale}
+}
+
+// localCurrency returns the currency of the local amounts of the
+// transaction, which is the default currency of its project
+func (s *Transaction) localCurrency() *string {
+	if s.Project == nil {
+		return nil
+	}
+
+	return s.Project.DefaultCurrency
+}
+
+// ExchangeRate returns the rate converting the amounts of the transaction
+// to the default currency of its project, computed from its Amount and
+// AmountLocal. An error is returned if either is missing or 0
+func (s *Transaction) ExchangeRate() (*big.Rat, error) {
+	if s.Amount == nil || s.AmountLocal == nil {
+		return nil, errors.New(nil, "", "The transaction has no amount and local amount to compute its exchange rate")
+	}
+	amount, err := ParseAmount(*s.Amount)
+	if err != nil {
+		return nil, err
+	}
+	local, err := ParseAmount(*s.AmountLocal)
+	if err != nil {
+		return nil, err
+	}
+	if amount.IsZero() || local.IsZero() {
+		return nil, errors.New(nil, "", "The exchange rate of a transaction of 0 can't be computed")
+	}
+
+	return new(big.Rat).Quo(local.Rat(), amount.Rat()), nil
+}
+
+// ToLocal converts the amount, in the currency of the transaction, to the
+// default currency of its project using the exchange rate of the
+// transaction, such as to compare a refund with the local amounts. The
+// result is rounded to the minor units of the project currency, which is
+// empty unless the Project of the transaction was expanded
+func (s *Transaction) ToLocal(m Money) (Money, error) {
+	return s.convert(m, s.Currency, s.localCurrency(), false)
+}
+
+// FromLocal converts the amount, in the default currency of the project of
+// the transaction, to the currency of the transaction. It is the inverse
+// of ToLocal
+func (s *Transaction) FromLocal(m Money) (Money, error) {
+	return s.convert(m, s.localCurrency(), s.Currency, true)
+}
+
+func (s *Transaction) convert(m Money, from, to *string, inverse bool) (Money, error) {
+	if err := m.check(NewMoney(Amount{}, ToString(from))); err != nil {
+		return Money{}, err
+	}
+	rate, err := s.ExchangeRate()
+	if err != nil {
+		return Money{}, err
+	}
+	if inverse {
+		rate.Inv(rate)
+	}
+
+	r := new(big.Rat).Mul(m.Amount.Rat(), rate)
+	currency := ToString(to)
+	return NewMoney(amountFromRat(r, MinorUnits(currency)), currency), nil
+}
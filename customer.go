@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -150,6 +151,11 @@ type CustomerFetchSubscriptionsParameters struct {
 
 // FetchSubscriptions allows you to get the subscriptions belonging to the customer.
 func (s Customer) FetchSubscriptions(options ...CustomerFetchSubscriptionsParameters) (*Iterator, error) {
+	return s.FetchSubscriptionsWithContext(context.Background(), options...)
+}
+
+// FetchSubscriptionsWithContext allows you to get the subscriptions belonging to the customer.
+func (s Customer) FetchSubscriptionsWithContext(ctx context.Context, options ...CustomerFetchSubscriptionsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -188,9 +194,10 @@ func (s Customer) FetchSubscriptions(options ...CustomerFetchSubscriptionsParame
 
 	path := "/customers/" + url.QueryEscape(*s.ID) + "/subscriptions"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -198,23 +205,31 @@ func (s Customer) FetchSubscriptions(options ...CustomerFetchSubscriptionsParame
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Customer",
+		Method:     "FetchSubscriptions",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -223,31 +238,53 @@ func (s Customer) FetchSubscriptions(options ...CustomerFetchSubscriptionsParame
 	for _, o := range payload.Subscriptions {
 		subscriptionsList = append(subscriptionsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	subscriptionsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Customer",
+			Method:     "FetchSubscriptions",
+			ResourceID: *s.ID,
+		},
 		data:    subscriptionsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"subscriptions"`
+				Data    []*Subscription `json:"subscriptions"`
 				HasMore bool            `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return subscriptionsIterator, nil
 }
 
+// FetchSubscriptionsTyped allows you to get the subscriptions belonging to the customer, through an iterator of *Subscription.
+func (s Customer) FetchSubscriptionsTyped(options ...CustomerFetchSubscriptionsParameters) (*TypedIterator[*Subscription], error) {
+	return s.FetchSubscriptionsTypedWithContext(context.Background(), options...)
+}
+
+// FetchSubscriptionsTypedWithContext allows you to get the subscriptions belonging to the customer, through an iterator of *Subscription.
+func (s Customer) FetchSubscriptionsTypedWithContext(ctx context.Context, options ...CustomerFetchSubscriptionsParameters) (*TypedIterator[*Subscription], error) {
+	it, err := s.FetchSubscriptionsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Subscription]{Iterator: it}, nil
+}
+
 // CustomerFetchTokensParameters is the structure representing the
 // additional parameters used to call Customer.FetchTokens
 type CustomerFetchTokensParameters struct {
@@ -257,6 +294,11 @@ type CustomerFetchTokensParameters struct {
 
 // FetchTokens allows you to get the customer's tokens.
 func (s Customer) FetchTokens(options ...CustomerFetchTokensParameters) (*Iterator, error) {
+	return s.FetchTokensWithContext(context.Background(), options...)
+}
+
+// FetchTokensWithContext allows you to get the customer's tokens.
+func (s Customer) FetchTokensWithContext(ctx context.Context, options ...CustomerFetchTokensParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -295,9 +337,10 @@ func (s Customer) FetchTokens(options ...CustomerFetchTokensParameters) (*Iterat
 
 	path := "/customers/" + url.QueryEscape(*s.ID) + "/tokens"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -305,23 +348,31 @@ func (s Customer) FetchTokens(options ...CustomerFetchTokensParameters) (*Iterat
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Customer",
+		Method:     "FetchTokens",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -330,31 +381,53 @@ func (s Customer) FetchTokens(options ...CustomerFetchTokensParameters) (*Iterat
 	for _, o := range payload.Tokens {
 		tokensList = append(tokensList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	tokensIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Customer",
+			Method:     "FetchTokens",
+			ResourceID: *s.ID,
+		},
 		data:    tokensList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"tokens"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Token `json:"tokens"`
+				HasMore bool     `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return tokensIterator, nil
 }
 
+// FetchTokensTyped allows you to get the customer's tokens, through an iterator of *Token.
+func (s Customer) FetchTokensTyped(options ...CustomerFetchTokensParameters) (*TypedIterator[*Token], error) {
+	return s.FetchTokensTypedWithContext(context.Background(), options...)
+}
+
+// FetchTokensTypedWithContext allows you to get the customer's tokens, through an iterator of *Token.
+func (s Customer) FetchTokensTypedWithContext(ctx context.Context, options ...CustomerFetchTokensParameters) (*TypedIterator[*Token], error) {
+	it, err := s.FetchTokensWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Token]{Iterator: it}, nil
+}
+
 // CustomerFindTokenParameters is the structure representing the
 // additional parameters used to call Customer.FindToken
 type CustomerFindTokenParameters struct {
@@ -364,6 +437,11 @@ type CustomerFindTokenParameters struct {
 
 // FindToken allows you to find a customer's token by its ID.
 func (s Customer) FindToken(tokenID string, options ...CustomerFindTokenParameters) (*Token, error) {
+	return s.FindTokenWithContext(context.Background(), tokenID, options...)
+}
+
+// FindTokenWithContext allows you to find a customer's token by its ID.
+func (s Customer) FindTokenWithContext(ctx context.Context, tokenID string, options ...CustomerFindTokenParameters) (*Token, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -401,9 +479,10 @@ func (s Customer) FindToken(tokenID string, options ...CustomerFindTokenParamete
 
 	path := "/customers/" + url.QueryEscape(*s.ID) + "/tokens/" + url.QueryEscape(tokenID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -411,23 +490,31 @@ func (s Customer) FindToken(tokenID string, options ...CustomerFindTokenParamete
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Customer",
+		Method:     "FindToken",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -445,6 +532,11 @@ type CustomerDeleteTokenParameters struct {
 
 // DeleteToken allows you to delete a customer's token by its ID.
 func (s Customer) DeleteToken(tokenID string, options ...CustomerDeleteTokenParameters) error {
+	return s.DeleteTokenWithContext(context.Background(), tokenID, options...)
+}
+
+// DeleteTokenWithContext allows you to delete a customer's token by its ID.
+func (s Customer) DeleteTokenWithContext(ctx context.Context, tokenID string, options ...CustomerDeleteTokenParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -481,9 +573,10 @@ func (s Customer) DeleteToken(tokenID string, options ...CustomerDeleteTokenPara
 
 	path := "/customers/" + url.QueryEscape(*s.ID) + "/tokens/" + url.QueryEscape(tokenID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -491,23 +584,31 @@ func (s Customer) DeleteToken(tokenID string, options ...CustomerDeleteTokenPara
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Customer",
+		Method:     "DeleteToken",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -524,6 +625,11 @@ type CustomerFetchTransactionsParameters struct {
 
 // FetchTransactions allows you to get the transactions belonging to the customer.
 func (s Customer) FetchTransactions(options ...CustomerFetchTransactionsParameters) (*Iterator, error) {
+	return s.FetchTransactionsWithContext(context.Background(), options...)
+}
+
+// FetchTransactionsWithContext allows you to get the transactions belonging to the customer.
+func (s Customer) FetchTransactionsWithContext(ctx context.Context, options ...CustomerFetchTransactionsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -562,9 +668,10 @@ func (s Customer) FetchTransactions(options ...CustomerFetchTransactionsParamete
 
 	path := "/customers/" + url.QueryEscape(*s.ID) + "/transactions"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -572,23 +679,31 @@ func (s Customer) FetchTransactions(options ...CustomerFetchTransactionsParamete
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Customer",
+		Method:     "FetchTransactions",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -597,31 +712,53 @@ func (s Customer) FetchTransactions(options ...CustomerFetchTransactionsParamete
 	for _, o := range payload.Transactions {
 		transactionsList = append(transactionsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	transactionsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Customer",
+			Method:     "FetchTransactions",
+			ResourceID: *s.ID,
+		},
 		data:    transactionsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"transactions"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Transaction `json:"transactions"`
+				HasMore bool           `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return transactionsIterator, nil
 }
 
+// FetchTransactionsTyped allows you to get the transactions belonging to the customer, through an iterator of *Transaction.
+func (s Customer) FetchTransactionsTyped(options ...CustomerFetchTransactionsParameters) (*TypedIterator[*Transaction], error) {
+	return s.FetchTransactionsTypedWithContext(context.Background(), options...)
+}
+
+// FetchTransactionsTypedWithContext allows you to get the transactions belonging to the customer, through an iterator of *Transaction.
+func (s Customer) FetchTransactionsTypedWithContext(ctx context.Context, options ...CustomerFetchTransactionsParameters) (*TypedIterator[*Transaction], error) {
+	it, err := s.FetchTransactionsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Transaction]{Iterator: it}, nil
+}
+
 // CustomerAllParameters is the structure representing the
 // additional parameters used to call Customer.All
 type CustomerAllParameters struct {
@@ -631,6 +768,11 @@ type CustomerAllParameters struct {
 
 // All allows you to get all the customers.
 func (s Customer) All(options ...CustomerAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the customers.
+func (s Customer) AllWithContext(ctx context.Context, options ...CustomerAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -669,9 +811,10 @@ func (s Customer) All(options ...CustomerAllParameters) (*Iterator, error) {
 
 	path := "/customers"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -679,23 +822,30 @@ func (s Customer) All(options ...CustomerAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Customer",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -704,31 +854,52 @@ func (s Customer) All(options ...CustomerAllParameters) (*Iterator, error) {
 	for _, o := range payload.Customers {
 		customersList = append(customersList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	customersIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Customer",
+			Method:   "All",
+		},
 		data:    customersList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"customers"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Customer `json:"customers"`
+				HasMore bool        `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return customersIterator, nil
 }
 
+// AllTyped allows you to get all the customers, through an iterator of *Customer.
+func (s Customer) AllTyped(options ...CustomerAllParameters) (*TypedIterator[*Customer], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the customers, through an iterator of *Customer.
+func (s Customer) AllTypedWithContext(ctx context.Context, options ...CustomerAllParameters) (*TypedIterator[*Customer], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Customer]{Iterator: it}, nil
+}
+
 // CustomerCreateParameters is the structure representing the
 // additional parameters used to call Customer.Create
 type CustomerCreateParameters struct {
@@ -738,6 +909,11 @@ type CustomerCreateParameters struct {
 
 // Create allows you to create a new customer.
 func (s Customer) Create(options ...CustomerCreateParameters) (*Customer, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new customer.
+func (s Customer) CreateWithContext(ctx context.Context, options ...CustomerCreateParameters) (*Customer, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -753,6 +929,13 @@ func (s Customer) Create(options ...CustomerCreateParameters) (*Customer, error)
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Customer)
+	if err := s.client.validate(Call{
+		Resource: "Customer",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Customer *Customer `json:"customer"`
@@ -815,9 +998,10 @@ func (s Customer) Create(options ...CustomerCreateParameters) (*Customer, error)
 
 	path := "/customers"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -825,23 +1009,30 @@ func (s Customer) Create(options ...CustomerCreateParameters) (*Customer, error)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Customer",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -859,6 +1050,11 @@ type CustomerFindParameters struct {
 
 // Find allows you to find a customer by its ID.
 func (s Customer) Find(customerID string, options ...CustomerFindParameters) (*Customer, error) {
+	return s.FindWithContext(context.Background(), customerID, options...)
+}
+
+// FindWithContext allows you to find a customer by its ID.
+func (s Customer) FindWithContext(ctx context.Context, customerID string, options ...CustomerFindParameters) (*Customer, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -896,9 +1092,10 @@ func (s Customer) Find(customerID string, options ...CustomerFindParameters) (*C
 
 	path := "/customers/" + url.QueryEscape(customerID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -906,23 +1103,31 @@ func (s Customer) Find(customerID string, options ...CustomerFindParameters) (*C
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Customer",
+		Method:     "Find",
+		ResourceID: customerID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -940,6 +1145,11 @@ type CustomerSaveParameters struct {
 
 // Save allows you to save the updated customer attributes.
 func (s Customer) Save(options ...CustomerSaveParameters) (*Customer, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated customer attributes.
+func (s Customer) SaveWithContext(ctx context.Context, options ...CustomerSaveParameters) (*Customer, error) {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -955,6 +1165,14 @@ func (s Customer) Save(options ...CustomerSaveParameters) (*Customer, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Customer)
+	if err := s.client.validate(Call{
+		Resource:   "Customer",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Customer *Customer `json:"customer"`
@@ -1015,9 +1233,10 @@ func (s Customer) Save(options ...CustomerSaveParameters) (*Customer, error) {
 
 	path := "/customers/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -1025,23 +1244,31 @@ func (s Customer) Save(options ...CustomerSaveParameters) (*Customer, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Customer",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -1059,6 +1286,11 @@ type CustomerDeleteParameters struct {
 
 // Delete allows you to delete the customer.
 func (s Customer) Delete(options ...CustomerDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete the customer.
+func (s Customer) DeleteWithContext(ctx context.Context, options ...CustomerDeleteParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewCustomer() method to create a new Customer object")
 	}
@@ -1095,9 +1327,10 @@ func (s Customer) Delete(options ...CustomerDeleteParameters) error {
 
 	path := "/customers/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -1105,23 +1338,31 @@ func (s Customer) Delete(options ...CustomerDeleteParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Customer",
+		Method:     "Delete",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -1129,6 +1370,17 @@ func (s Customer) Delete(options ...CustomerDeleteParameters) error {
 	return nil
 }
 
+// BalanceMoney returns the balance of the customer in its currency
+func (s *Customer) BalanceMoney() (Money, error) {
+	return moneyOf(s.Balance, s.Currency)
+}
+
+// Validate returns a validation error if the currency of the customer isn't
+// an ISO 4217 currency. It implements Validator
+func (s *Customer) Validate() error {
+	return validateCurrency("currency", s.Currency)
+}
+
 // dummyCustomer is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
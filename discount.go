@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -108,6 +109,11 @@ type DiscountFetchSubscriptionDiscountsParameters struct {
 
 // FetchSubscriptionDiscounts allows you to get the discounts applied to the subscription.
 func (s Discount) FetchSubscriptionDiscounts(subscriptionID string, options ...DiscountFetchSubscriptionDiscountsParameters) (*Iterator, error) {
+	return s.FetchSubscriptionDiscountsWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FetchSubscriptionDiscountsWithContext allows you to get the discounts applied to the subscription.
+func (s Discount) FetchSubscriptionDiscountsWithContext(ctx context.Context, subscriptionID string, options ...DiscountFetchSubscriptionDiscountsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewDiscount() method to create a new Discount object")
 	}
@@ -146,9 +152,10 @@ func (s Discount) FetchSubscriptionDiscounts(subscriptionID string, options ...D
 
 	path := "/subscriptions/" + url.QueryEscape(subscriptionID) + "/discounts"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -156,23 +163,30 @@ func (s Discount) FetchSubscriptionDiscounts(subscriptionID string, options ...D
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Discount",
+		Method:   "FetchSubscriptionDiscounts",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -181,31 +195,52 @@ func (s Discount) FetchSubscriptionDiscounts(subscriptionID string, options ...D
 	for _, o := range payload.Discounts {
 		discountsList = append(discountsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	discountsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Discount",
+			Method:   "FetchSubscriptionDiscounts",
+		},
 		data:    discountsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"discounts"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Discount `json:"discounts"`
+				HasMore bool        `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return discountsIterator, nil
 }
 
+// FetchSubscriptionDiscountsTyped allows you to get the discounts applied to the subscription, through an iterator of *Discount.
+func (s Discount) FetchSubscriptionDiscountsTyped(subscriptionID string, options ...DiscountFetchSubscriptionDiscountsParameters) (*TypedIterator[*Discount], error) {
+	return s.FetchSubscriptionDiscountsTypedWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FetchSubscriptionDiscountsTypedWithContext allows you to get the discounts applied to the subscription, through an iterator of *Discount.
+func (s Discount) FetchSubscriptionDiscountsTypedWithContext(ctx context.Context, subscriptionID string, options ...DiscountFetchSubscriptionDiscountsParameters) (*TypedIterator[*Discount], error) {
+	it, err := s.FetchSubscriptionDiscountsWithContext(ctx, subscriptionID, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Discount]{Iterator: it}, nil
+}
+
 // DiscountCreateParameters is the structure representing the
 // additional parameters used to call Discount.Create
 type DiscountCreateParameters struct {
@@ -215,6 +250,11 @@ type DiscountCreateParameters struct {
 
 // Create allows you to create a new discount for the given subscription ID.
 func (s Discount) Create(options ...DiscountCreateParameters) (*Discount, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new discount for the given subscription ID.
+func (s Discount) CreateWithContext(ctx context.Context, options ...DiscountCreateParameters) (*Discount, error) {
 	if s.client == nil {
 		panic("Please use the client.NewDiscount() method to create a new Discount object")
 	}
@@ -230,6 +270,13 @@ func (s Discount) Create(options ...DiscountCreateParameters) (*Discount, error)
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Discount)
+	if err := s.client.validate(Call{
+		Resource: "Discount",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Discount *Discount `json:"discount"`
@@ -262,9 +309,10 @@ func (s Discount) Create(options ...DiscountCreateParameters) (*Discount, error)
 
 	path := "/subscriptions/" + url.QueryEscape(*s.SubscriptionID) + "/discounts"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -272,23 +320,30 @@ func (s Discount) Create(options ...DiscountCreateParameters) (*Discount, error)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Discount",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -306,6 +361,11 @@ type DiscountFindParameters struct {
 
 // Find allows you to find a subscription's discount by its ID.
 func (s Discount) Find(subscriptionID, discountID string, options ...DiscountFindParameters) (*Discount, error) {
+	return s.FindWithContext(context.Background(), subscriptionID, discountID, options...)
+}
+
+// FindWithContext allows you to find a subscription's discount by its ID.
+func (s Discount) FindWithContext(ctx context.Context, subscriptionID, discountID string, options ...DiscountFindParameters) (*Discount, error) {
 	if s.client == nil {
 		panic("Please use the client.NewDiscount() method to create a new Discount object")
 	}
@@ -343,9 +403,10 @@ func (s Discount) Find(subscriptionID, discountID string, options ...DiscountFin
 
 	path := "/subscriptions/" + url.QueryEscape(subscriptionID) + "/discounts/" + url.QueryEscape(discountID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -353,23 +414,31 @@ func (s Discount) Find(subscriptionID, discountID string, options ...DiscountFin
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Discount",
+		Method:     "Find",
+		ResourceID: discountID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -387,6 +456,11 @@ type DiscountDeleteParameters struct {
 
 // Delete allows you to delete a discount applied to a subscription.
 func (s Discount) Delete(options ...DiscountDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete a discount applied to a subscription.
+func (s Discount) DeleteWithContext(ctx context.Context, options ...DiscountDeleteParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewDiscount() method to create a new Discount object")
 	}
@@ -423,9 +497,10 @@ func (s Discount) Delete(options ...DiscountDeleteParameters) error {
 
 	path := "/subscriptions/" + url.QueryEscape(*s.SubscriptionID) + "/discounts/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -433,23 +508,31 @@ func (s Discount) Delete(options ...DiscountDeleteParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Discount",
+		Method:     "Delete",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -457,6 +540,17 @@ func (s Discount) Delete(options ...DiscountDeleteParameters) error {
 	return nil
 }
 
+// AmountMoney returns the amount of the discount in the currency of its
+// subscription, which is empty unless Subscription was expanded
+func (s *Discount) AmountMoney() (Money, error) {
+	var currency *string
+	if s.Subscription != nil {
+		currency = s.Subscription.Currency
+	}
+
+	return moneyOf(s.Amount, currency)
+}
+
 // dummyDiscount is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -4,6 +4,7 @@ package errors
 type AuthenticationError struct {
 	message string
 	code    string
+	response
 }
 
 // Error returns the error message
@@ -15,3 +16,13 @@ func (e *AuthenticationError) Error() string {
 func (e *AuthenticationError) Code() string {
 	return e.code
 }
+
+// Retryable returns whether or not the request can be retried
+func (e *AuthenticationError) Retryable() bool {
+	return false
+}
+
+// Is allows the error to match ErrAuthentication using errors.Is
+func (e *AuthenticationError) Is(target error) bool {
+	return target == ErrAuthentication
+}
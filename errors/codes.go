@@ -0,0 +1,163 @@
+package errors
+
+import (
+	stderrors "errors"
+	"strings"
+)
+
+// Error codes returned by ProcessOut, either as the error_type of a failed
+// API request or as the ErrorCode of a failed transaction or transaction
+// operation
+const (
+	// CodeNetworkError is used when the API could not be reached
+	CodeNetworkError = "processout.network-error"
+
+	CodeCardDeclined            = "card.declined"
+	CodeCardInsufficientFunds   = "card.insufficient-funds"
+	CodeCardDoNotHonor          = "card.do-not-honor"
+	CodeCardExceededLimits      = "card.exceeded-limits"
+	CodeCardNeedsAuthentication = "card.needs-authentication"
+	CodeCardIssuerDown          = "card.issuer-down"
+	CodeCardNetworkFailed       = "card.network-failed"
+	CodeCardNoActionTaken       = "card.no-action-taken"
+	CodeCardExpired             = "card.expired"
+	CodeCardInvalid             = "card.invalid"
+	CodeCardInvalidNumber       = "card.invalid-number"
+	CodeCardInvalidCVC          = "card.invalid-cvc"
+	CodeCardInvalidExpiryDate   = "card.invalid-expiry-date"
+	CodeCardInvalidPIN          = "card.invalid-pin"
+	CodeCardFailedCVC           = "card.failed-cvc"
+	CodeCardFailedAVS           = "card.failed-avs"
+	CodeCardFailedThreeDS       = "card.failed-three-d-s"
+	CodeCardNotAuthorized       = "card.not-authorized"
+	CodeCardNotRegistered       = "card.not-registered"
+	CodeCardDuplicate           = "card.duplicate"
+	CodeCardLost                = "card.lost"
+	CodeCardStolen              = "card.stolen"
+	CodeCardPickup              = "card.pickup"
+	CodeCardSecurityViolation   = "card.security-violation"
+	CodeCardSuspectedFraud      = "card.suspected-fraud"
+
+	CodeGatewayDeclined             = "gateway.declined"
+	CodeGatewayTimeout              = "gateway.timeout"
+	CodeGatewayInternalError        = "gateway.internal-error"
+	CodeGatewayUnknownError         = "gateway.unknown-error"
+	CodeGatewayInvalidConfiguration = "gateway.invalid-configuration"
+
+	CodePaymentDeclined = "payment.declined"
+	CodeRiskDeclined    = "risk.declined"
+
+	CodeRequestValidationError = "request.validation.error"
+	CodeRequestInvalidCurrency = "request.validation.invalid-currency"
+	CodeRequestAuthentication  = "request.authentication.invalid"
+	CodeRequestNotFound        = "request.route.not-found"
+	CodeResourceNotFound       = "resource.not-found"
+)
+
+// Class is the classification of an error code, telling whether a failed
+// payment or request may be attempted again
+type Class int
+
+const (
+	// ClassUnknown is used for error codes that are not part of the
+	// catalogue
+	ClassUnknown Class = iota
+	// ClassHardDecline is used for payments that won't succeed if retried
+	// with the same payment details
+	ClassHardDecline
+	// ClassSoftDecline is used for payments that may succeed if retried
+	// later or after authenticating the customer
+	ClassSoftDecline
+	// ClassFraud is used for payments declined because of a suspected fraud.
+	// They must not be retried
+	ClassFraud
+	// ClassIntegration is used for requests rejected because of a bug or a
+	// misconfiguration of the integration
+	ClassIntegration
+)
+
+// String returns the name of the class
+func (c Class) String() string {
+	switch c {
+	case ClassHardDecline:
+		return "hard-decline"
+	case ClassSoftDecline:
+		return "soft-decline"
+	case ClassFraud:
+		return "fraud"
+	case ClassIntegration:
+		return "integration"
+	}
+
+	return "unknown"
+}
+
+// Retryable returns whether or not payments failing with an error code of
+// the class may be retried
+func (c Class) Retryable() bool {
+	return c == ClassSoftDecline
+}
+
+// codeClasses maps the error codes of the catalogue to their class
+var codeClasses = map[string]Class{
+	CodeCardDeclined:            ClassSoftDecline,
+	CodeCardInsufficientFunds:   ClassSoftDecline,
+	CodeCardDoNotHonor:          ClassSoftDecline,
+	CodeCardExceededLimits:      ClassSoftDecline,
+	CodeCardNeedsAuthentication: ClassSoftDecline,
+	CodeCardIssuerDown:          ClassSoftDecline,
+	CodeCardNetworkFailed:       ClassSoftDecline,
+	CodeCardNoActionTaken:       ClassSoftDecline,
+	CodeGatewayTimeout:          ClassSoftDecline,
+	CodeGatewayInternalError:    ClassSoftDecline,
+	CodeGatewayUnknownError:     ClassSoftDecline,
+
+	CodeCardExpired:           ClassHardDecline,
+	CodeCardInvalid:           ClassHardDecline,
+	CodeCardInvalidNumber:     ClassHardDecline,
+	CodeCardInvalidCVC:        ClassHardDecline,
+	CodeCardInvalidExpiryDate: ClassHardDecline,
+	CodeCardInvalidPIN:        ClassHardDecline,
+	CodeCardFailedCVC:         ClassHardDecline,
+	CodeCardFailedAVS:         ClassHardDecline,
+	CodeCardFailedThreeDS:     ClassHardDecline,
+	CodeCardNotAuthorized:     ClassHardDecline,
+	CodeCardNotRegistered:     ClassHardDecline,
+	CodeCardDuplicate:         ClassHardDecline,
+	CodeGatewayDeclined:       ClassHardDecline,
+	CodePaymentDeclined:       ClassHardDecline,
+
+	CodeCardLost:              ClassFraud,
+	CodeCardStolen:            ClassFraud,
+	CodeCardPickup:            ClassFraud,
+	CodeCardSecurityViolation: ClassFraud,
+	CodeCardSuspectedFraud:    ClassFraud,
+	CodeRiskDeclined:          ClassFraud,
+
+	CodeGatewayInvalidConfiguration: ClassIntegration,
+	CodeResourceNotFound:            ClassIntegration,
+}
+
+// Classify returns the class of the given error code, such as the ErrorCode
+// of a failed transaction. Codes of rejected requests (request.*) are
+// classified as integration errors
+func Classify(code string) Class {
+	if c, ok := codeClasses[code]; ok {
+		return c
+	}
+	if strings.HasPrefix(code, "request.") {
+		return ClassIntegration
+	}
+
+	return ClassUnknown
+}
+
+// ClassOf returns the class of the code of the given ProcessOut error
+func ClassOf(err error) Class {
+	var cerr CodedError
+	if !stderrors.As(err, &cerr) {
+		return ClassUnknown
+	}
+
+	return Classify(cerr.Code())
+}
@@ -6,6 +6,16 @@ type CodedError interface {
 	Code() string
 }
 
+// ResponseError is the interface implemented by ProcessOut errors to expose
+// the details of the API response they were built from
+type ResponseError interface {
+	CodedError
+	StatusCode() int
+	RequestID() string
+	Body() string
+	Retryable() bool
+}
+
 // New creates a new ProcessOut error from an error
 func New(err error, code, message string) error {
 	if err != nil {
@@ -18,8 +28,14 @@ func New(err error, code, message string) error {
 	}
 }
 
-// NewNetworkError creates a new ProcessOut network error from an error
+// NewNetworkError creates a new ProcessOut network error from an error.
+// err is returned as is if it already is a ProcessOut error, such as one
+// returned by a middleware short-circuiting an API call
 func NewNetworkError(err error) error {
+	if _, ok := err.(CodedError); ok {
+		return err
+	}
+
 	message := "network error"
 	if err != nil {
 		message = err.Error()
@@ -27,36 +43,59 @@ func NewNetworkError(err error) error {
 	return &NetworkError{
 		err:     err,
 		message: message,
-		code:    "processout.network-error",
+		code:    CodeNetworkError,
+	}
+}
+
+// NewValidationError creates a validation error for a request rejected
+// before being sent to ProcessOut, because of the given fields
+func NewValidationError(code, message string, fields ...FieldError) error {
+	return &ValidationError{
+		message: message,
+		code:    code,
+		fields:  fields,
 	}
 }
 
 // NewFromResponse creates an error from a response data
 func NewFromResponse(status int, code, message string) error {
+	return newFromResponse(response{status: status}, code, message)
+}
+
+func newFromResponse(r response, code, message string) error {
+	status := r.status
 	if status == 404 {
 		return &NotFoundError{
-			message: message,
-			code:    code,
+			message:  message,
+			code:     code,
+			response: r,
 		}
 	}
 	if status == 401 {
 		return &AuthenticationError{
-			message: message,
-			code:    code,
+			message:  message,
+			code:     code,
+			response: r,
 		}
 	}
 	if status >= 400 && status < 500 {
 		return &ValidationError{
-			message: message,
-			code:    code,
+			message:  message,
+			code:     code,
+			response: r,
 		}
 	}
 	if status >= 500 {
 		return &InternalError{
-			message: message,
-			code:    code,
+			message:  message,
+			code:     code,
+			response: r,
 		}
 	}
 
-	return New(nil, code, message)
+	return &Error{
+		message:  message,
+		code:     code,
+		response: r,
+	}
 }
@@ -0,0 +1,42 @@
+package errors
+
+import (
+	"encoding/json"
+)
+
+// FieldError describes why a field of a request was rejected by ProcessOut
+type FieldError struct {
+	// Field is the name of the rejected field, such as amount
+	Field string `json:"field"`
+	// Code is the error code of the rejection
+	Code string `json:"error_type"`
+	// Message is the human readable reason of the rejection
+	Message string `json:"message"`
+}
+
+// fieldErrors is the list of field errors sent by ProcessOut. It can be
+// decoded from either a list of objects or an object mapping field names to
+// messages
+type fieldErrors []FieldError
+
+// UnmarshalJSON implements json.Unmarshaler
+func (f *fieldErrors) UnmarshalJSON(b []byte) error {
+	list := []FieldError{}
+	if err := json.Unmarshal(b, &list); err == nil {
+		*f = list
+		return nil
+	}
+
+	m := map[string]string{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for k, v := range m {
+		list = append(list, FieldError{
+			Field:   k,
+			Message: v,
+		})
+	}
+	*f = list
+	return nil
+}
@@ -5,6 +5,7 @@ type Error struct {
 	err     error
 	message string
 	code    string
+	response
 }
 
 // Error returns the error message
@@ -21,3 +22,13 @@ func (e *Error) Code() string {
 func (e *Error) Root() error {
 	return e.err
 }
+
+// Unwrap returns the root error, if any
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Retryable returns whether or not the request can be retried
+func (e *Error) Retryable() bool {
+	return false
+}
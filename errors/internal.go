@@ -4,6 +4,7 @@ package errors
 type InternalError struct {
 	message string
 	code    string
+	response
 }
 
 // Error returns the error message
@@ -15,3 +16,14 @@ func (e *InternalError) Error() string {
 func (e *InternalError) Code() string {
 	return e.code
 }
+
+// Retryable returns whether or not the request can be retried. Internal
+// errors are transient and may be retried
+func (e *InternalError) Retryable() bool {
+	return true
+}
+
+// Is allows the error to match ErrInternal using errors.Is
+func (e *InternalError) Is(target error) bool {
+	return target == ErrInternal
+}
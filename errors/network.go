@@ -1,10 +1,16 @@
 package errors
 
+import (
+	"context"
+	stderrors "errors"
+)
+
 // NetworkError is a ProcessOut network error
 type NetworkError struct {
 	err     error
 	message string
 	code    string
+	response
 }
 
 // Error returns the error message
@@ -16,3 +22,24 @@ func (e *NetworkError) Error() string {
 func (e *NetworkError) Code() string {
 	return e.code
 }
+
+// Retryable returns whether or not the request can be retried. Network
+// errors are transient and may be retried, unless the request was canceled
+func (e *NetworkError) Retryable() bool {
+	return !stderrors.Is(e.err, context.Canceled)
+}
+
+// Root returns the root error, if any
+func (e *NetworkError) Root() error {
+	return e.err
+}
+
+// Unwrap returns the root error, if any
+func (e *NetworkError) Unwrap() error {
+	return e.err
+}
+
+// Is allows the error to match ErrNetwork using errors.Is
+func (e *NetworkError) Is(target error) bool {
+	return target == ErrNetwork
+}
@@ -4,6 +4,7 @@ package errors
 type NotFoundError struct {
 	message string
 	code    string
+	response
 }
 
 // Error returns the error message
@@ -15,3 +16,13 @@ func (e *NotFoundError) Error() string {
 func (e *NotFoundError) Code() string {
 	return e.code
 }
+
+// Retryable returns whether or not the request can be retried
+func (e *NotFoundError) Retryable() bool {
+	return false
+}
+
+// Is allows the error to match ErrNotFound using errors.Is
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
@@ -0,0 +1,103 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// maxBodySnippet is the maximum number of bytes of the response body kept
+// in errors
+const maxBodySnippet = 2048
+
+// requestIDHeaders are the response headers that may contain the ID
+// ProcessOut gave to the request, in order of preference
+var requestIDHeaders = []string{
+	"Request-Id",
+	"X-Request-Id",
+	"X-ProcessOut-Request-Id",
+}
+
+// RequestID returns the ID ProcessOut gave to the request of the response,
+// if any
+func RequestID(res *http.Response) string {
+	if res == nil {
+		return ""
+	}
+
+	for _, h := range requestIDHeaders {
+		if id := res.Header.Get(h); id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// response holds the details of the HTTP response an error was built from
+type response struct {
+	status    int
+	requestID string
+	body      string
+}
+
+// StatusCode returns the HTTP status code of the response the error was
+// built from, or 0 if the error didn't come from an API response
+func (r response) StatusCode() int {
+	return r.status
+}
+
+// RequestID returns the ID ProcessOut gave to the request, as sent in the
+// response headers, if any. It should be provided when contacting the
+// ProcessOut support about a failure
+func (r response) RequestID() string {
+	return r.requestID
+}
+
+// Body returns the beginning of the raw response body the error was built
+// from, if any
+func (r response) Body() string {
+	return r.body
+}
+
+// newResponse extracts the error details from an HTTP response
+func newResponse(res *http.Response, body []byte) response {
+	r := response{}
+	if res == nil {
+		return r
+	}
+
+	r.status = res.StatusCode
+	r.requestID = RequestID(res)
+	if len(body) > maxBodySnippet {
+		body = body[:maxBodySnippet]
+	}
+	r.body = string(body)
+
+	return r
+}
+
+// NewFromHTTPResponse creates an error from an API response and its body.
+// The error type and message sent by ProcessOut are used when present, and
+// the error keeps the response status, request ID and a snippet of its body
+func NewFromHTTPResponse(res *http.Response, body []byte) error {
+	payload := struct {
+		Message string      `json:"message"`
+		Code    string      `json:"error_type"`
+		Fields  fieldErrors `json:"fields"`
+	}{}
+	json.Unmarshal(body, &payload)
+
+	r := newResponse(res, body)
+	if payload.Message == "" {
+		payload.Message = fmt.Sprintf("unexpected response from ProcessOut: %d %s",
+			r.status, http.StatusText(r.status))
+	}
+
+	err := newFromResponse(r, payload.Code, payload.Message)
+	if verr, ok := err.(*ValidationError); ok {
+		verr.fields = payload.Fields
+	}
+
+	return err
+}
@@ -0,0 +1,41 @@
+package errors
+
+import (
+	stderrors "errors"
+)
+
+// sentinel is the type of the sentinel errors of the package. ProcessOut
+// errors match them when compared with the standard errors.Is
+type sentinel string
+
+// Error returns the error message
+func (s sentinel) Error() string {
+	return string(s)
+}
+
+var (
+	// ErrNotFound is matched by NotFoundError
+	ErrNotFound error = sentinel("processout: resource not found")
+	// ErrAuthentication is matched by AuthenticationError
+	ErrAuthentication error = sentinel("processout: authentication failed")
+	// ErrValidation is matched by ValidationError
+	ErrValidation error = sentinel("processout: request validation failed")
+	// ErrInternal is matched by InternalError
+	ErrInternal error = sentinel("processout: internal error")
+	// ErrNetwork is matched by NetworkError
+	ErrNetwork error = sentinel("processout: network error")
+)
+
+// IsRetryable returns whether or not the request that failed with the given
+// error can safely be retried, provided it is sent with the same idempotency
+// key
+func IsRetryable(err error) bool {
+	var r interface {
+		Retryable() bool
+	}
+	if stderrors.As(err, &r) {
+		return r.Retryable()
+	}
+
+	return false
+}
@@ -4,6 +4,8 @@ package errors
 type ValidationError struct {
 	message string
 	code    string
+	fields  []FieldError
+	response
 }
 
 // Error returns the error message
@@ -15,3 +17,20 @@ func (e *ValidationError) Error() string {
 func (e *ValidationError) Code() string {
 	return e.code
 }
+
+// Fields returns the list of the fields rejected by ProcessOut, when the
+// API provided them
+func (e *ValidationError) Fields() []FieldError {
+	return e.fields
+}
+
+// Retryable returns whether or not the request can be retried. Only
+// requests that were rate limited may be retried
+func (e *ValidationError) Retryable() bool {
+	return e.status == 429
+}
+
+// Is allows the error to match ErrValidation using errors.Is
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
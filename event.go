@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -81,6 +82,11 @@ type EventFetchWebhooksParameters struct {
 
 // FetchWebhooks allows you to get all the webhooks of the event.
 func (s Event) FetchWebhooks(options ...EventFetchWebhooksParameters) (*Iterator, error) {
+	return s.FetchWebhooksWithContext(context.Background(), options...)
+}
+
+// FetchWebhooksWithContext allows you to get all the webhooks of the event.
+func (s Event) FetchWebhooksWithContext(ctx context.Context, options ...EventFetchWebhooksParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewEvent() method to create a new Event object")
 	}
@@ -119,9 +125,10 @@ func (s Event) FetchWebhooks(options ...EventFetchWebhooksParameters) (*Iterator
 
 	path := "/events/" + url.QueryEscape(*s.ID) + "/webhooks"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -129,23 +136,31 @@ func (s Event) FetchWebhooks(options ...EventFetchWebhooksParameters) (*Iterator
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Event",
+		Method:     "FetchWebhooks",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -154,31 +169,53 @@ func (s Event) FetchWebhooks(options ...EventFetchWebhooksParameters) (*Iterator
 	for _, o := range payload.Webhooks {
 		webhooksList = append(webhooksList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	webhooksIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Event",
+			Method:     "FetchWebhooks",
+			ResourceID: *s.ID,
+		},
 		data:    webhooksList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"webhooks"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Webhook `json:"webhooks"`
+				HasMore bool       `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return webhooksIterator, nil
 }
 
+// FetchWebhooksTyped allows you to get all the webhooks of the event, through an iterator of *Webhook.
+func (s Event) FetchWebhooksTyped(options ...EventFetchWebhooksParameters) (*TypedIterator[*Webhook], error) {
+	return s.FetchWebhooksTypedWithContext(context.Background(), options...)
+}
+
+// FetchWebhooksTypedWithContext allows you to get all the webhooks of the event, through an iterator of *Webhook.
+func (s Event) FetchWebhooksTypedWithContext(ctx context.Context, options ...EventFetchWebhooksParameters) (*TypedIterator[*Webhook], error) {
+	it, err := s.FetchWebhooksWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Webhook]{Iterator: it}, nil
+}
+
 // EventAllParameters is the structure representing the
 // additional parameters used to call Event.All
 type EventAllParameters struct {
@@ -188,6 +225,11 @@ type EventAllParameters struct {
 
 // All allows you to get all the events.
 func (s Event) All(options ...EventAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the events.
+func (s Event) AllWithContext(ctx context.Context, options ...EventAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewEvent() method to create a new Event object")
 	}
@@ -226,9 +268,10 @@ func (s Event) All(options ...EventAllParameters) (*Iterator, error) {
 
 	path := "/events"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -236,23 +279,30 @@ func (s Event) All(options ...EventAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Event",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -261,31 +311,52 @@ func (s Event) All(options ...EventAllParameters) (*Iterator, error) {
 	for _, o := range payload.Events {
 		eventsList = append(eventsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	eventsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Event",
+			Method:   "All",
+		},
 		data:    eventsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"events"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Event `json:"events"`
+				HasMore bool     `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return eventsIterator, nil
 }
 
+// AllTyped allows you to get all the events, through an iterator of *Event.
+func (s Event) AllTyped(options ...EventAllParameters) (*TypedIterator[*Event], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the events, through an iterator of *Event.
+func (s Event) AllTypedWithContext(ctx context.Context, options ...EventAllParameters) (*TypedIterator[*Event], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Event]{Iterator: it}, nil
+}
+
 // EventFindParameters is the structure representing the
 // additional parameters used to call Event.Find
 type EventFindParameters struct {
@@ -295,6 +366,11 @@ type EventFindParameters struct {
 
 // Find allows you to find an event by its ID.
 func (s Event) Find(eventID string, options ...EventFindParameters) (*Event, error) {
+	return s.FindWithContext(context.Background(), eventID, options...)
+}
+
+// FindWithContext allows you to find an event by its ID.
+func (s Event) FindWithContext(ctx context.Context, eventID string, options ...EventFindParameters) (*Event, error) {
 	if s.client == nil {
 		panic("Please use the client.NewEvent() method to create a new Event object")
 	}
@@ -332,9 +408,10 @@ func (s Event) Find(eventID string, options ...EventFindParameters) (*Event, err
 
 	path := "/events/" + url.QueryEscape(eventID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -342,23 +419,31 @@ func (s Event) Find(eventID string, options ...EventFindParameters) (*Event, err
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Event",
+		Method:     "Find",
+		ResourceID: eventID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -0,0 +1,172 @@
+package processout
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"gopkg.in/processout.v4/errors"
+)
+
+// EventDataDecoder decodes the data of an event into a concrete type, and
+// attaches the given client to it
+type EventDataDecoder func(data json.RawMessage, c *ProcessOut) (interface{}, error)
+
+var (
+	eventDataMu       sync.RWMutex
+	eventDataDecoders = map[string]EventDataDecoder{
+		"invoice":      resourceEventData[Invoice]("invoice"),
+		"transaction":  resourceEventData[Transaction]("transaction"),
+		"subscription": resourceEventData[Subscription]("subscription"),
+		"refund":       resourceEventData[Refund]("refund"),
+		"payout":       resourceEventData[Payout]("payout"),
+		"customer":     resourceEventData[Customer]("customer"),
+		"token":        resourceEventData[Token]("token"),
+		"card":         resourceEventData[Card]("card"),
+	}
+)
+
+// RegisterEventData registers the decoder used by Event.Decode for the
+// events with the given name, such as transaction.captured. A name without
+// a dot, such as transaction, registers the decoder for all the events of
+// that resource. Decoders registered for a full event name take precedence
+func RegisterEventData(name string, decoder EventDataDecoder) {
+	eventDataMu.Lock()
+	defer eventDataMu.Unlock()
+
+	eventDataDecoders[name] = decoder
+}
+
+// Decode decodes the data of the event into the type registered for its
+// name, such as *Transaction for transaction.captured events. The client of
+// the event is attached to the decoded data
+func (s *Event) Decode() (interface{}, error) {
+	if s.Name == nil {
+		return nil, errors.New(nil, "", "The event has no name, its data can't be decoded")
+	}
+
+	eventDataMu.RLock()
+	decoder, ok := eventDataDecoders[*s.Name]
+	if !ok {
+		decoder, ok = eventDataDecoders[eventResource(*s.Name)]
+	}
+	eventDataMu.RUnlock()
+	if !ok {
+		return nil, errors.New(nil, "", "No data type is registered for the event "+*s.Name)
+	}
+
+	data, err := json.Marshal(s.Data)
+	if err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	return decoder(data, s.client)
+}
+
+// Invoice decodes the invoice contained in the data of the event
+func (s *Event) Invoice() (*Invoice, error) {
+	return decodeEventData[Invoice](s, "invoice")
+}
+
+// Transaction decodes the transaction contained in the data of the event
+func (s *Event) Transaction() (*Transaction, error) {
+	return decodeEventData[Transaction](s, "transaction")
+}
+
+// Subscription decodes the subscription contained in the data of the event
+func (s *Event) Subscription() (*Subscription, error) {
+	return decodeEventData[Subscription](s, "subscription")
+}
+
+// Refund decodes the refund contained in the data of the event
+func (s *Event) Refund() (*Refund, error) {
+	return decodeEventData[Refund](s, "refund")
+}
+
+// Payout decodes the payout contained in the data of the event
+func (s *Event) Payout() (*Payout, error) {
+	return decodeEventData[Payout](s, "payout")
+}
+
+// Customer decodes the customer contained in the data of the event
+func (s *Event) Customer() (*Customer, error) {
+	return decodeEventData[Customer](s, "customer")
+}
+
+// Token decodes the token contained in the data of the event
+func (s *Event) Token() (*Token, error) {
+	return decodeEventData[Token](s, "token")
+}
+
+// eventResource returns the name of the resource an event is about, such as
+// transaction for transaction.captured
+func eventResource(name string) string {
+	if i := strings.Index(name, "."); i >= 0 {
+		return name[:i]
+	}
+
+	return name
+}
+
+// eventResourceData is implemented by the pointers to the resources that
+// can be found in the data of events
+type eventResourceData[T any] interface {
+	*T
+	SetClient(*ProcessOut) *T
+}
+
+// resourceEventData returns a decoder reading the resource found under the
+// given key of the event data
+func resourceEventData[T any, P eventResourceData[T]](key string) EventDataDecoder {
+	return func(data json.RawMessage, c *ProcessOut) (interface{}, error) {
+		v, err := unmarshalEventData[T](data, key, true)
+		if err != nil {
+			return nil, err
+		}
+
+		return P(v).SetClient(c), nil
+	}
+}
+
+// decodeEventData decodes the resource found under the given key of the data
+// of the event. When the key is missing, the data itself is decoded if the
+// event is about that resource
+func decodeEventData[T any, P eventResourceData[T]](s *Event, key string) (*T, error) {
+	data, err := json.Marshal(s.Data)
+	if err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	whole := s.Name != nil && eventResource(*s.Name) == key
+	v, err := unmarshalEventData[T](data, key, whole)
+	if err != nil {
+		return nil, err
+	}
+
+	return P(v).SetClient(s.client), nil
+}
+
+func unmarshalEventData[T any](data []byte, key string, whole bool) (*T, error) {
+	if string(data) == "null" {
+		return nil, errors.New(nil, "", "The event has no data")
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	raw, ok := fields[key]
+	if !ok || string(raw) == "null" {
+		if !whole {
+			return nil, errors.New(nil, "", "The event data does not contain any "+key)
+		}
+		raw = data
+	}
+
+	v := new(T)
+	if err := json.Unmarshal(raw, v); err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	return v, nil
+}
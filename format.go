@@ -0,0 +1,116 @@
+package processout
+
+import (
+	"strings"
+)
+
+// amountLocale describes how the amounts are formatted in a locale
+type amountLocale struct {
+	// decimal separates the integer part of the amounts from their decimals
+	decimal string
+	// group separates the groups of thousands
+	group string
+	// symbolAfter is true when the currency symbol follows the amount
+	symbolAfter bool
+	// space is true when the symbol is separated from the amount by a
+	// non-breaking space
+	space bool
+}
+
+// amountLocales are the locales amounts can be formatted in, keyed by
+// language or language and region. The locales missing from the list are
+// formatted like English
+var amountLocales = map[string]amountLocale{
+	"en":    {decimal: ".", group: ","},
+	"ja":    {decimal: ".", group: ","},
+	"ko":    {decimal: ".", group: ","},
+	"zh":    {decimal: ".", group: ","},
+	"th":    {decimal: ".", group: ","},
+	"he":    {decimal: ".", group: ",", space: true},
+	"fr":    {decimal: ",", group: "\u202f", symbolAfter: true, space: true},
+	"fr-CH": {decimal: ",", group: "\u202f", symbolAfter: true, space: true},
+	"de":    {decimal: ",", group: ".", symbolAfter: true, space: true},
+	"de-AT": {decimal: ",", group: "\u00a0", space: true},
+	"de-CH": {decimal: ".", group: "’", space: true},
+	"es":    {decimal: ",", group: ".", symbolAfter: true, space: true},
+	"es-MX": {decimal: ".", group: ","},
+	"es-US": {decimal: ".", group: ","},
+	"it":    {decimal: ",", group: ".", symbolAfter: true, space: true},
+	"nl":    {decimal: ",", group: ".", space: true},
+	"pt":    {decimal: ",", group: "\u00a0", symbolAfter: true, space: true},
+	"pt-BR": {decimal: ",", group: ".", space: true},
+	"da":    {decimal: ",", group: ".", symbolAfter: true, space: true},
+	"sv":    {decimal: ",", group: "\u00a0", symbolAfter: true, space: true},
+	"nb":    {decimal: ",", group: "\u00a0", symbolAfter: true, space: true},
+	"fi":    {decimal: ",", group: "\u00a0", symbolAfter: true, space: true},
+	"pl":    {decimal: ",", group: "\u00a0", symbolAfter: true, space: true},
+	"cs":    {decimal: ",", group: "\u00a0", symbolAfter: true, space: true},
+	"ru":    {decimal: ",", group: "\u00a0", symbolAfter: true, space: true},
+	"uk":    {decimal: ",", group: "\u00a0", symbolAfter: true, space: true},
+	"tr":    {decimal: ",", group: "."},
+}
+
+// lookupAmountLocale returns the formatting of the given BCP 47 locale,
+// such as fr-FR or en_US, falling back to its language and then to English
+func lookupAmountLocale(tag string) amountLocale {
+	parts := strings.FieldsFunc(tag, func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	if len(parts) == 0 {
+		return amountLocales["en"]
+	}
+
+	lang := strings.ToLower(parts[0])
+	if len(parts) > 1 {
+		if l, ok := amountLocales[lang+"-"+strings.ToUpper(parts[1])]; ok {
+			return l
+		}
+	}
+	if l, ok := amountLocales[lang]; ok {
+		return l
+	}
+
+	return amountLocales["en"]
+}
+
+// Format formats the amount for display in the given BCP 47 locale, such
+// as en-US or fr-FR, with the symbol of its currency. The amount is rounded
+// to the minor units of its currency: 1234.5 USD is formatted as $1,234.50
+// in en-US and 1 234,50 $ in fr-FR, with non-breaking spaces. The currency
+// code is used when the currency has no symbol
+func (m Money) Format(locale string) string {
+	l := lookupAmountLocale(locale)
+
+	s := m.Round().Amount.Abs().String()
+	integer, decimals, _ := strings.Cut(s, ".")
+	groups := []string{}
+	for len(integer) > 3 {
+		groups = append([]string{integer[len(integer)-3:]}, groups...)
+		integer = integer[:len(integer)-3]
+	}
+	s = strings.Join(append([]string{integer}, groups...), l.group)
+	if decimals != "" {
+		s += l.decimal + decimals
+	}
+
+	symbol := m.Currency
+	if c, ok := LookupCurrency(m.Currency); ok && c.Symbol != "" {
+		symbol = c.Symbol
+	}
+	if symbol != "" {
+		sep := ""
+		if l.space {
+			sep = "\u00a0"
+		}
+		if l.symbolAfter {
+			s = s + sep + symbol
+		} else {
+			s = symbol + sep + s
+		}
+	}
+	if m.Sign() < 0 {
+		s = "-" + s
+	}
+
+	return s
+}
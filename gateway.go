@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -90,6 +91,11 @@ type GatewayFetchGatewayConfigurationsParameters struct {
 
 // FetchGatewayConfigurations allows you to get all the gateway configurations of the gateway
 func (s Gateway) FetchGatewayConfigurations(options ...GatewayFetchGatewayConfigurationsParameters) (*Iterator, error) {
+	return s.FetchGatewayConfigurationsWithContext(context.Background(), options...)
+}
+
+// FetchGatewayConfigurationsWithContext allows you to get all the gateway configurations of the gateway
+func (s Gateway) FetchGatewayConfigurationsWithContext(ctx context.Context, options ...GatewayFetchGatewayConfigurationsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewGateway() method to create a new Gateway object")
 	}
@@ -128,9 +134,10 @@ func (s Gateway) FetchGatewayConfigurations(options ...GatewayFetchGatewayConfig
 
 	path := "/gateways/" + url.QueryEscape(*s.Name) + "/gateway-configurations"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -138,23 +145,30 @@ func (s Gateway) FetchGatewayConfigurations(options ...GatewayFetchGatewayConfig
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Gateway",
+		Method:   "FetchGatewayConfigurations",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -163,31 +177,52 @@ func (s Gateway) FetchGatewayConfigurations(options ...GatewayFetchGatewayConfig
 	for _, o := range payload.GatewayConfigurations {
 		gatewayConfigurationsList = append(gatewayConfigurationsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	gatewayConfigurationsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Gateway",
+			Method:   "FetchGatewayConfigurations",
+		},
 		data:    gatewayConfigurationsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"gateway_configurations"`
-				HasMore bool            `json:"has_more"`
+				Data    []*GatewayConfiguration `json:"gateway_configurations"`
+				HasMore bool                    `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return gatewayConfigurationsIterator, nil
 }
 
+// FetchGatewayConfigurationsTyped allows you to get all the gateway configurations of the gateway, through an iterator of *GatewayConfiguration.
+func (s Gateway) FetchGatewayConfigurationsTyped(options ...GatewayFetchGatewayConfigurationsParameters) (*TypedIterator[*GatewayConfiguration], error) {
+	return s.FetchGatewayConfigurationsTypedWithContext(context.Background(), options...)
+}
+
+// FetchGatewayConfigurationsTypedWithContext allows you to get all the gateway configurations of the gateway, through an iterator of *GatewayConfiguration.
+func (s Gateway) FetchGatewayConfigurationsTypedWithContext(ctx context.Context, options ...GatewayFetchGatewayConfigurationsParameters) (*TypedIterator[*GatewayConfiguration], error) {
+	it, err := s.FetchGatewayConfigurationsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*GatewayConfiguration]{Iterator: it}, nil
+}
+
 // dummyGateway is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -97,6 +98,11 @@ type GatewayConfigurationAllParameters struct {
 
 // All allows you to get all the gateway configurations.
 func (s GatewayConfiguration) All(options ...GatewayConfigurationAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the gateway configurations.
+func (s GatewayConfiguration) AllWithContext(ctx context.Context, options ...GatewayConfigurationAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewGatewayConfiguration() method to create a new GatewayConfiguration object")
 	}
@@ -137,9 +143,10 @@ func (s GatewayConfiguration) All(options ...GatewayConfigurationAllParameters)
 
 	path := "/gateway-configurations"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -147,23 +154,30 @@ func (s GatewayConfiguration) All(options ...GatewayConfigurationAllParameters)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "GatewayConfiguration",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -172,31 +186,52 @@ func (s GatewayConfiguration) All(options ...GatewayConfigurationAllParameters)
 	for _, o := range payload.GatewayConfigurations {
 		gatewayConfigurationsList = append(gatewayConfigurationsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	gatewayConfigurationsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "GatewayConfiguration",
+			Method:   "All",
+		},
 		data:    gatewayConfigurationsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"gateway_configurations"`
-				HasMore bool            `json:"has_more"`
+				Data    []*GatewayConfiguration `json:"gateway_configurations"`
+				HasMore bool                    `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return gatewayConfigurationsIterator, nil
 }
 
+// AllTyped allows you to get all the gateway configurations, through an iterator of *GatewayConfiguration.
+func (s GatewayConfiguration) AllTyped(options ...GatewayConfigurationAllParameters) (*TypedIterator[*GatewayConfiguration], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the gateway configurations, through an iterator of *GatewayConfiguration.
+func (s GatewayConfiguration) AllTypedWithContext(ctx context.Context, options ...GatewayConfigurationAllParameters) (*TypedIterator[*GatewayConfiguration], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*GatewayConfiguration]{Iterator: it}, nil
+}
+
 // GatewayConfigurationFindParameters is the structure representing the
 // additional parameters used to call GatewayConfiguration.Find
 type GatewayConfigurationFindParameters struct {
@@ -206,6 +241,11 @@ type GatewayConfigurationFindParameters struct {
 
 // Find allows you to find a gateway configuration by its ID.
 func (s GatewayConfiguration) Find(configurationID string, options ...GatewayConfigurationFindParameters) (*GatewayConfiguration, error) {
+	return s.FindWithContext(context.Background(), configurationID, options...)
+}
+
+// FindWithContext allows you to find a gateway configuration by its ID.
+func (s GatewayConfiguration) FindWithContext(ctx context.Context, configurationID string, options ...GatewayConfigurationFindParameters) (*GatewayConfiguration, error) {
 	if s.client == nil {
 		panic("Please use the client.NewGatewayConfiguration() method to create a new GatewayConfiguration object")
 	}
@@ -243,9 +283,10 @@ func (s GatewayConfiguration) Find(configurationID string, options ...GatewayCon
 
 	path := "/gateway-configurations/" + url.QueryEscape(configurationID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -253,23 +294,31 @@ func (s GatewayConfiguration) Find(configurationID string, options ...GatewayCon
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "GatewayConfiguration",
+		Method:     "Find",
+		ResourceID: configurationID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -289,6 +338,11 @@ type GatewayConfigurationSaveParameters struct {
 
 // Save allows you to save the updated gateway configuration attributes and settings.
 func (s GatewayConfiguration) Save(options ...GatewayConfigurationSaveParameters) (*GatewayConfiguration, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated gateway configuration attributes and settings.
+func (s GatewayConfiguration) SaveWithContext(ctx context.Context, options ...GatewayConfigurationSaveParameters) (*GatewayConfiguration, error) {
 	if s.client == nil {
 		panic("Please use the client.NewGatewayConfiguration() method to create a new GatewayConfiguration object")
 	}
@@ -304,6 +358,14 @@ func (s GatewayConfiguration) Save(options ...GatewayConfigurationSaveParameters
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.GatewayConfiguration)
+	if err := s.client.validate(Call{
+		Resource:   "GatewayConfiguration",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		GatewayConfiguration *GatewayConfiguration `json:"gateway_configuration"`
@@ -338,9 +400,10 @@ func (s GatewayConfiguration) Save(options ...GatewayConfigurationSaveParameters
 
 	path := "/gateway-configurations/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -348,23 +411,31 @@ func (s GatewayConfiguration) Save(options ...GatewayConfigurationSaveParameters
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "GatewayConfiguration",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -382,6 +453,11 @@ type GatewayConfigurationDeleteParameters struct {
 
 // Delete allows you to delete the gateway configuration.
 func (s GatewayConfiguration) Delete(options ...GatewayConfigurationDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete the gateway configuration.
+func (s GatewayConfiguration) DeleteWithContext(ctx context.Context, options ...GatewayConfigurationDeleteParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewGatewayConfiguration() method to create a new GatewayConfiguration object")
 	}
@@ -418,9 +494,10 @@ func (s GatewayConfiguration) Delete(options ...GatewayConfigurationDeleteParame
 
 	path := "/gateway-configurations/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -428,23 +505,31 @@ func (s GatewayConfiguration) Delete(options ...GatewayConfigurationDeleteParame
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "GatewayConfiguration",
+		Method:     "Delete",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -463,6 +548,11 @@ type GatewayConfigurationCreateParameters struct {
 
 // Create allows you to create a new gateway configuration.
 func (s GatewayConfiguration) Create(gatewayName string, options ...GatewayConfigurationCreateParameters) (*GatewayConfiguration, error) {
+	return s.CreateWithContext(context.Background(), gatewayName, options...)
+}
+
+// CreateWithContext allows you to create a new gateway configuration.
+func (s GatewayConfiguration) CreateWithContext(ctx context.Context, gatewayName string, options ...GatewayConfigurationCreateParameters) (*GatewayConfiguration, error) {
 	if s.client == nil {
 		panic("Please use the client.NewGatewayConfiguration() method to create a new GatewayConfiguration object")
 	}
@@ -478,6 +568,13 @@ func (s GatewayConfiguration) Create(gatewayName string, options ...GatewayConfi
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.GatewayConfiguration)
+	if err := s.client.validate(Call{
+		Resource: "GatewayConfiguration",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		GatewayConfiguration *GatewayConfiguration `json:"gateway_configuration"`
@@ -512,9 +609,10 @@ func (s GatewayConfiguration) Create(gatewayName string, options ...GatewayConfi
 
 	path := "/gateways/" + url.QueryEscape(gatewayName) + "/gateway-configurations"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -522,23 +620,30 @@ func (s GatewayConfiguration) Create(gatewayName string, options ...GatewayConfi
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "GatewayConfiguration",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -0,0 +1,3 @@
+module gopkg.in/processout.v4
+
+go 1.22
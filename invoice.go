@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -194,6 +195,11 @@ type InvoiceAuthorizeParameters struct {
 
 // Authorize allows you to authorize the invoice using the given source (customer or token)
 func (s Invoice) Authorize(source string, options ...InvoiceAuthorizeParameters) (*Transaction, error) {
+	return s.AuthorizeWithContext(context.Background(), source, options...)
+}
+
+// AuthorizeWithContext allows you to authorize the invoice using the given source (customer or token)
+func (s Invoice) AuthorizeWithContext(ctx context.Context, source string, options ...InvoiceAuthorizeParameters) (*Transaction, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -245,9 +251,10 @@ func (s Invoice) Authorize(source string, options ...InvoiceAuthorizeParameters)
 
 	path := "/invoices/" + url.QueryEscape(*s.ID) + "/authorize"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -255,23 +262,31 @@ func (s Invoice) Authorize(source string, options ...InvoiceAuthorizeParameters)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Invoice",
+		Method:     "Authorize",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -295,6 +310,11 @@ type InvoiceCaptureParameters struct {
 
 // Capture allows you to capture the invoice using the given source (customer or token)
 func (s Invoice) Capture(source string, options ...InvoiceCaptureParameters) (*Transaction, error) {
+	return s.CaptureWithContext(context.Background(), source, options...)
+}
+
+// CaptureWithContext allows you to capture the invoice using the given source (customer or token)
+func (s Invoice) CaptureWithContext(ctx context.Context, source string, options ...InvoiceCaptureParameters) (*Transaction, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -348,9 +368,10 @@ func (s Invoice) Capture(source string, options ...InvoiceCaptureParameters) (*T
 
 	path := "/invoices/" + url.QueryEscape(*s.ID) + "/capture"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -358,23 +379,31 @@ func (s Invoice) Capture(source string, options ...InvoiceCaptureParameters) (*T
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Invoice",
+		Method:     "Capture",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -392,6 +421,11 @@ type InvoiceFetchCustomerParameters struct {
 
 // FetchCustomer allows you to get the customer linked to the invoice.
 func (s Invoice) FetchCustomer(options ...InvoiceFetchCustomerParameters) (*Customer, error) {
+	return s.FetchCustomerWithContext(context.Background(), options...)
+}
+
+// FetchCustomerWithContext allows you to get the customer linked to the invoice.
+func (s Invoice) FetchCustomerWithContext(ctx context.Context, options ...InvoiceFetchCustomerParameters) (*Customer, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -429,9 +463,10 @@ func (s Invoice) FetchCustomer(options ...InvoiceFetchCustomerParameters) (*Cust
 
 	path := "/invoices/" + url.QueryEscape(*s.ID) + "/customers"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -439,23 +474,31 @@ func (s Invoice) FetchCustomer(options ...InvoiceFetchCustomerParameters) (*Cust
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Invoice",
+		Method:     "FetchCustomer",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -473,6 +516,11 @@ type InvoiceAssignCustomerParameters struct {
 
 // AssignCustomer allows you to assign a customer to the invoice.
 func (s Invoice) AssignCustomer(customerID string, options ...InvoiceAssignCustomerParameters) (*Customer, error) {
+	return s.AssignCustomerWithContext(context.Background(), customerID, options...)
+}
+
+// AssignCustomerWithContext allows you to assign a customer to the invoice.
+func (s Invoice) AssignCustomerWithContext(ctx context.Context, customerID string, options ...InvoiceAssignCustomerParameters) (*Customer, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -512,9 +560,10 @@ func (s Invoice) AssignCustomer(customerID string, options ...InvoiceAssignCusto
 
 	path := "/invoices/" + url.QueryEscape(*s.ID) + "/customers"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -522,23 +571,31 @@ func (s Invoice) AssignCustomer(customerID string, options ...InvoiceAssignCusto
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Invoice",
+		Method:     "AssignCustomer",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -557,6 +614,11 @@ type InvoiceInitiateThreeDSParameters struct {
 
 // InitiateThreeDS allows you to initiate a 3-D Secure authentication
 func (s Invoice) InitiateThreeDS(source string, options ...InvoiceInitiateThreeDSParameters) (*CustomerAction, error) {
+	return s.InitiateThreeDSWithContext(context.Background(), source, options...)
+}
+
+// InitiateThreeDSWithContext allows you to initiate a 3-D Secure authentication
+func (s Invoice) InitiateThreeDSWithContext(ctx context.Context, source string, options ...InvoiceInitiateThreeDSParameters) (*CustomerAction, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -598,9 +660,10 @@ func (s Invoice) InitiateThreeDS(source string, options ...InvoiceInitiateThreeD
 
 	path := "/invoices/" + url.QueryEscape(*s.ID) + "/three-d-s"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -608,23 +671,31 @@ func (s Invoice) InitiateThreeDS(source string, options ...InvoiceInitiateThreeD
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Invoice",
+		Method:     "InitiateThreeDS",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -642,6 +713,11 @@ type InvoiceFetchTransactionParameters struct {
 
 // FetchTransaction allows you to get the transaction of the invoice.
 func (s Invoice) FetchTransaction(options ...InvoiceFetchTransactionParameters) (*Transaction, error) {
+	return s.FetchTransactionWithContext(context.Background(), options...)
+}
+
+// FetchTransactionWithContext allows you to get the transaction of the invoice.
+func (s Invoice) FetchTransactionWithContext(ctx context.Context, options ...InvoiceFetchTransactionParameters) (*Transaction, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -679,9 +755,10 @@ func (s Invoice) FetchTransaction(options ...InvoiceFetchTransactionParameters)
 
 	path := "/invoices/" + url.QueryEscape(*s.ID) + "/transactions"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -689,23 +766,31 @@ func (s Invoice) FetchTransaction(options ...InvoiceFetchTransactionParameters)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Invoice",
+		Method:     "FetchTransaction",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -723,6 +808,11 @@ type InvoiceVoidParameters struct {
 
 // Void allows you to void the invoice
 func (s Invoice) Void(options ...InvoiceVoidParameters) (*Transaction, error) {
+	return s.VoidWithContext(context.Background(), options...)
+}
+
+// VoidWithContext allows you to void the invoice
+func (s Invoice) VoidWithContext(ctx context.Context, options ...InvoiceVoidParameters) (*Transaction, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -760,9 +850,10 @@ func (s Invoice) Void(options ...InvoiceVoidParameters) (*Transaction, error) {
 
 	path := "/invoices/" + url.QueryEscape(*s.ID) + "/void"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -770,23 +861,31 @@ func (s Invoice) Void(options ...InvoiceVoidParameters) (*Transaction, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Invoice",
+		Method:     "Void",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -804,6 +903,11 @@ type InvoiceAllParameters struct {
 
 // All allows you to get all the invoices.
 func (s Invoice) All(options ...InvoiceAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the invoices.
+func (s Invoice) AllWithContext(ctx context.Context, options ...InvoiceAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -842,9 +946,10 @@ func (s Invoice) All(options ...InvoiceAllParameters) (*Iterator, error) {
 
 	path := "/invoices"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -852,23 +957,30 @@ func (s Invoice) All(options ...InvoiceAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Invoice",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -877,31 +989,52 @@ func (s Invoice) All(options ...InvoiceAllParameters) (*Iterator, error) {
 	for _, o := range payload.Invoices {
 		invoicesList = append(invoicesList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	invoicesIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Invoice",
+			Method:   "All",
+		},
 		data:    invoicesList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"invoices"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Invoice `json:"invoices"`
+				HasMore bool       `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return invoicesIterator, nil
 }
 
+// AllTyped allows you to get all the invoices, through an iterator of *Invoice.
+func (s Invoice) AllTyped(options ...InvoiceAllParameters) (*TypedIterator[*Invoice], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the invoices, through an iterator of *Invoice.
+func (s Invoice) AllTypedWithContext(ctx context.Context, options ...InvoiceAllParameters) (*TypedIterator[*Invoice], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Invoice]{Iterator: it}, nil
+}
+
 // InvoiceCreateParameters is the structure representing the
 // additional parameters used to call Invoice.Create
 type InvoiceCreateParameters struct {
@@ -911,6 +1044,11 @@ type InvoiceCreateParameters struct {
 
 // Create allows you to create a new invoice.
 func (s Invoice) Create(options ...InvoiceCreateParameters) (*Invoice, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new invoice.
+func (s Invoice) CreateWithContext(ctx context.Context, options ...InvoiceCreateParameters) (*Invoice, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -926,6 +1064,13 @@ func (s Invoice) Create(options ...InvoiceCreateParameters) (*Invoice, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Invoice)
+	if err := s.client.validate(Call{
+		Resource: "Invoice",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Invoice *Invoice `json:"invoice"`
@@ -992,9 +1137,10 @@ func (s Invoice) Create(options ...InvoiceCreateParameters) (*Invoice, error) {
 
 	path := "/invoices"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -1002,23 +1148,30 @@ func (s Invoice) Create(options ...InvoiceCreateParameters) (*Invoice, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Invoice",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -1036,6 +1189,11 @@ type InvoiceFindParameters struct {
 
 // Find allows you to find an invoice by its ID.
 func (s Invoice) Find(invoiceID string, options ...InvoiceFindParameters) (*Invoice, error) {
+	return s.FindWithContext(context.Background(), invoiceID, options...)
+}
+
+// FindWithContext allows you to find an invoice by its ID.
+func (s Invoice) FindWithContext(ctx context.Context, invoiceID string, options ...InvoiceFindParameters) (*Invoice, error) {
 	if s.client == nil {
 		panic("Please use the client.NewInvoice() method to create a new Invoice object")
 	}
@@ -1073,9 +1231,10 @@ func (s Invoice) Find(invoiceID string, options ...InvoiceFindParameters) (*Invo
 
 	path := "/invoices/" + url.QueryEscape(invoiceID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -1083,23 +1242,31 @@ func (s Invoice) Find(invoiceID string, options ...InvoiceFindParameters) (*Invo
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Invoice",
+		Method:     "Find",
+		ResourceID: invoiceID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -1108,6 +1275,17 @@ func (s Invoice) Find(invoiceID string, options ...InvoiceFindParameters) (*Invo
 	return payload.Invoice, nil
 }
 
+// AmountMoney returns the amount of the invoice in its currency
+func (s *Invoice) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, s.Currency)
+}
+
+// Validate returns a validation error if the currency of the invoice isn't
+// an ISO 4217 currency. It implements Validator
+func (s *Invoice) Validate() error {
+	return validateCurrency("currency", s.Currency)
+}
+
 // dummyInvoice is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
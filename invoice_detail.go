@@ -84,6 +84,18 @@ func (s *InvoiceDetail) Prefill(c *InvoiceDetail) *InvoiceDetail {
 	return s
 }
 
+// AmountMoney returns the amount of the invoice detail, which has no
+// currency
+func (s *InvoiceDetail) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, nil)
+}
+
+// DiscountAmountMoney returns the discount amount of the invoice detail,
+// which has no currency
+func (s *InvoiceDetail) DiscountAmountMoney() (Money, error) {
+	return moneyOf(s.DiscountAmount, nil)
+}
+
 // dummyInvoiceDetail is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
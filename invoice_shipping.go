@@ -75,6 +75,12 @@ func (s *InvoiceShipping) Prefill(c *InvoiceShipping) *InvoiceShipping {
 	return s
 }
 
+// AmountMoney returns the amount of the invoice shipping, which has no
+// currency
+func (s *InvoiceShipping) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, nil)
+}
+
 // dummyInvoiceShipping is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -0,0 +1,312 @@
+package processout
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimit is a limit on the requests sent to the API: a token bucket
+// limiting their rate and a cap on the number of requests in flight. The
+// zero value doesn't limit anything
+type RateLimit struct {
+	// Rate is the number of requests that may be sent per second, on
+	// average. Zero means no rate limit
+	Rate float64
+	// Burst is the number of requests that may be sent at once, when no
+	// request was sent for a while. It defaults to Rate, and to at least 1
+	Burst int
+	// MaxInFlight is the maximum number of requests waiting for their
+	// response at the same time. Zero means no cap
+	MaxInFlight int
+}
+
+// Limiter throttles the requests sent by a client, making them wait rather
+// than fail. Requests wait until the global limit and the limit of their
+// endpoint allow them to be sent, or until their context is done. When the
+// API answers with a 429 status, all the requests are paused until the
+// delay of its Retry-After header elapsed. The rate limited request itself
+// isn't sent again by the Limiter: it is only retried once the pause is
+// over if the RetryPolicy of the client retries 429 statuses, as
+// DefaultRetryPolicy does. Otherwise its error is returned.
+//
+// A request stays in flight until the body of its response was read to the
+// end or closed.
+//
+// A Limiter may be shared by several clients
+type Limiter struct {
+	// RateLimitedDelay is the delay requests are paused for after a 429
+	// response without a Retry-After header. One second is used when zero
+	RateLimitedDelay time.Duration
+
+	mu          sync.Mutex
+	global      *limit
+	endpoints   map[string]*limit
+	pausedUntil time.Time
+}
+
+// NewLimiter creates a new Limiter applying the given limit to all the
+// requests
+func NewLimiter(global RateLimit) *Limiter {
+	return &Limiter{
+		global:    newLimit(global),
+		endpoints: map[string]*limit{},
+	}
+}
+
+// SetEndpoint sets the limit of the requests made by the given endpoint,
+// either a resource method such as Customer.Find or a resource such as
+// Customer for all its methods. The limit of a method takes precedence
+// over the one of its resource. Both the global limit and the limit of the
+// endpoint apply
+func (l *Limiter) SetEndpoint(endpoint string, rl RateLimit) *Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.endpoints[endpoint] = newLimit(rl)
+	return l
+}
+
+// endpoint returns the limit of the endpoint of the call, if any
+func (l *Limiter) endpoint(call *Call) *limit {
+	if e, ok := l.endpoints[call.Resource+"."+call.Method]; ok {
+		return e
+	}
+
+	return l.endpoints[call.Resource]
+}
+
+// wait blocks until the request of the call may be sent, or until the
+// context is done. The returned function, if any, releases the in-flight
+// slots of the request and must be called once its response was consumed.
+// It may be called several times
+func (l *Limiter) wait(ctx context.Context, call *Call) (func(), error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	for {
+		l.mu.Lock()
+		pause := time.Until(l.pausedUntil)
+		l.mu.Unlock()
+		if pause <= 0 {
+			break
+		}
+		if err := sleep(ctx, pause); err != nil {
+			return nil, err
+		}
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	limits := []*limit{l.global}
+	if e := l.endpoint(call); e != nil {
+		limits = append(limits, e)
+	}
+	var delay time.Duration
+	for _, lim := range limits {
+		delay = max(delay, lim.reserve(now))
+	}
+	l.mu.Unlock()
+
+	if err := sleep(ctx, delay); err != nil {
+		l.mu.Lock()
+		for _, lim := range limits {
+			lim.cancel()
+		}
+		l.mu.Unlock()
+		return nil, err
+	}
+
+	acquired := []*limit{}
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			for _, lim := range acquired {
+				lim.release()
+			}
+		})
+	}
+	for _, lim := range limits {
+		if lim.slots == nil {
+			continue
+		}
+		if err := lim.acquire(ctx); err != nil {
+			release()
+			return nil, err
+		}
+		acquired = append(acquired, lim)
+	}
+	if len(acquired) == 0 {
+		return nil, nil
+	}
+
+	return release, nil
+}
+
+// releaseBody calls release once the body of the response was read to the
+// end or closed, so that the request stays in flight until then
+func releaseBody(res *http.Response, release func()) {
+	if release == nil {
+		return
+	}
+	if res == nil || res.Body == nil {
+		release()
+		return
+	}
+
+	res.Body = &releasingBody{ReadCloser: res.Body, release: release}
+}
+
+// releasingBody is a response body calling release once read to the end
+// or closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.EOF {
+		b.release()
+	}
+	return n, err
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// observe pauses the requests when the API answered with a 429 status
+func (l *Limiter) observe(res *http.Response) {
+	if l == nil || res == nil || res.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+
+	d, ok := retryAfter(res)
+	if !ok {
+		d = l.RateLimitedDelay
+		if d <= 0 {
+			d = time.Second
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	until := time.Now().Add(d)
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+	// The requests sent once the pause is over must not burst again
+	l.global.drain(until)
+	for _, e := range l.endpoints {
+		e.drain(until)
+	}
+}
+
+// limit is the state of a RateLimit. Its token bucket is guarded by the
+// mutex of its Limiter
+type limit struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	slots  chan struct{}
+}
+
+func newLimit(rl RateLimit) *limit {
+	l := &limit{rate: rl.Rate}
+	if rl.Rate > 0 {
+		l.burst = float64(rl.Burst)
+		if l.burst <= 0 {
+			l.burst = math.Max(1, math.Floor(rl.Rate))
+		}
+		l.tokens = l.burst
+	}
+	if rl.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, rl.MaxInFlight)
+	}
+
+	return l
+}
+
+// reserve takes a token from the bucket, and returns how long to wait for
+// before it becomes available. The bucket may go into debt so that the
+// requests waiting are sent in order
+func (l *limit) reserve(now time.Time) time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+
+	if !l.last.IsZero() && now.After(l.last) {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	if now.After(l.last) {
+		l.last = now
+	}
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back the token of a request that won't be sent
+func (l *limit) cancel() {
+	if l.rate > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+1)
+	}
+}
+
+// drain empties the bucket until the given time
+func (l *limit) drain(until time.Time) {
+	if l.rate > 0 {
+		l.tokens = math.Min(l.tokens, 0)
+		l.last = until
+	}
+}
+
+// acquire takes a slot for a request in flight, waiting for one to be
+// released if needed
+func (l *limit) acquire(ctx context.Context) error {
+	if l.slots == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release releases the slot of a request in flight
+func (l *limit) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// sleep waits for the given delay, or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -1,8 +1,12 @@
 package processout
 
 import (
+	"context"
+	"encoding/json"
 	"io"
 	"net/http"
+
+	"gopkg.in/processout.v4/errors"
 )
 
 // Identifiable is the interface used by the Iterator to get the ID of the
@@ -15,14 +19,30 @@ type Identifiable interface {
 type Iterator struct {
 	pos     int
 	path    string
+	call    Call
 	data    []Identifiable
 	options *Options
-	decoder func(io.Reader, interface{}) (bool, error)
+	decoder func([]byte) ([]Identifiable, bool, error)
 	client  *ProcessOut
 	err     error
 
 	hasMoreNext bool
 	hasMorePrev bool
+	// after is the cursor the current page was fetched after, or nil for
+	// the first page, fetched with the options of the iterator
+	after *string
+}
+
+// pageHasMore returns whether or not there are more elements after and
+// before the first page of a listing, from the has_more flag returned by
+// the API. The flag describes the direction of the listing, which is
+// backward when it was listed before a cursor
+func pageHasMore(opt *Options, hasMore bool) (next, prev bool) {
+	if opt.EndBefore != "" {
+		return true, hasMore
+	}
+
+	return hasMore, opt.StartAfter != ""
 }
 
 // Error returns the error that occured when paginating, if any
@@ -35,95 +55,278 @@ func (i *Iterator) Data() []Identifiable {
 	return i.data
 }
 
-// Get returns the current element
+// Get returns the current element, or nil if the iterator isn't positioned
+// on an element
 func (i *Iterator) Get() interface{} {
+	if i.pos < 0 || i.pos >= len(i.data) {
+		return nil
+	}
+
 	return i.data[i.pos]
 }
 
+// Cursor returns the ID of the current element, which can be stored to
+// later resume the iteration after it using ResumeFrom. When no element of
+// the current page was iterated on yet, the cursor the page was fetched
+// after is returned
+func (i *Iterator) Cursor() string {
+	if i.pos >= 0 && i.pos < len(i.data) {
+		return i.data[i.pos].GetID()
+	}
+	if i.pos >= len(i.data) && len(i.data) > 0 {
+		return i.data[len(i.data)-1].GetID()
+	}
+	if i.after != nil {
+		return *i.after
+	}
+
+	return i.options.StartAfter
+}
+
+// ResumeFrom resets the iterator so that the next call to Next fetches the
+// elements following the given cursor, as returned by Cursor
+func (i *Iterator) ResumeFrom(cursor string) {
+	opt := *i.options
+	opt.StartAfter = cursor
+	opt.EndBefore = ""
+	i.options = &opt
+
+	i.data = nil
+	i.pos = -1
+	i.err = nil
+	i.hasMoreNext = true
+	i.hasMorePrev = cursor != ""
+	i.after = nil
+}
+
 // Next iterates on the objects list and fetches new data if available
 func (i *Iterator) Next() bool {
-	if len(i.data) == 0 {
+	return i.NextWithContext(context.Background())
+}
+
+// NextWithContext iterates on the objects list and fetches new data if
+// available. The given context is used for any page fetch made along the way
+func (i *Iterator) NextWithContext(ctx context.Context) bool {
+	if i.err != nil {
 		return false
 	}
-
-	i.pos++
-	if i.pos < len(i.data) {
+	if i.pos+1 < len(i.data) {
+		i.pos++
 		return true
 	}
-
 	if !i.hasMoreNext {
+		i.pos = len(i.data)
 		return false
 	}
-	hasMore, err := i.NextPage()
-	if err != nil {
+
+	if _, err := i.NextPageWithContext(ctx); err != nil {
 		i.err = err
 		return false
 	}
+	if i.pos+1 < len(i.data) {
+		i.pos++
+		return true
+	}
 
-	i.hasMoreNext = hasMore
-	i.pos = -1
-	return i.Next()
+	return false
 }
 
 // NextPage fetches the next data page
 func (i *Iterator) NextPage() (bool, error) {
-	prev := i.data[len(i.data)-1]
-	i.options.StartAfter = prev.GetID()
+	return i.NextPageWithContext(context.Background())
+}
+
+// NextPageWithContext fetches the next data page, bound to the given context.
+// The current data is kept if the next page is empty
+func (i *Iterator) NextPageWithContext(ctx context.Context) (bool, error) {
+	cursor := i.options.StartAfter
+	if len(i.data) > 0 {
+		cursor = i.data[len(i.data)-1].GetID()
+	}
 
+	data, hasMore, err := i.fetchPage(ctx, cursor, "")
+	if err != nil {
+		return false, err
+	}
+	if len(data) == 0 {
+		i.hasMoreNext = false
+		i.pos = len(i.data)
+		return false, nil
+	}
+
+	i.data = data
+	i.pos = -1
+	i.hasMoreNext = hasMore
 	i.hasMorePrev = true
-	return i.fetchPage()
+	i.after = &cursor
+	return hasMore, nil
 }
 
 // Prev iterates on the objects list and fetches new data if available
 func (i *Iterator) Prev() bool {
-	if len(i.data) == 0 {
+	return i.PrevWithContext(context.Background())
+}
+
+// PrevWithContext iterates on the objects list and fetches new data if
+// available. The given context is used for any page fetch made along the way
+func (i *Iterator) PrevWithContext(ctx context.Context) bool {
+	if i.err != nil {
 		return false
 	}
-
-	i.pos--
-	if i.pos > 0 {
+	if i.pos-1 >= 0 && i.pos-1 < len(i.data) {
+		i.pos--
 		return true
 	}
-
 	if !i.hasMorePrev {
+		i.pos = -1
 		return false
 	}
-	hasMore, err := i.PrevPage()
-	if err != nil {
+
+	if _, err := i.PrevPageWithContext(ctx); err != nil {
 		i.err = err
 		return false
 	}
+	if i.pos-1 >= 0 && i.pos-1 < len(i.data) {
+		i.pos--
+		return true
+	}
 
-	i.hasMorePrev = hasMore
-	i.pos = len(i.data)
-	return i.Prev()
+	return false
 }
 
 // PrevPage fetches the previous data page
 func (i *Iterator) PrevPage() (bool, error) {
-	next := i.data[0]
-	i.options.EndBefore = next.GetID()
+	return i.PrevPageWithContext(context.Background())
+}
 
+// PrevPageWithContext fetches the previous data page, bound to the given
+// context. The current data is kept if the previous page is empty
+func (i *Iterator) PrevPageWithContext(ctx context.Context) (bool, error) {
+	cursor := i.options.StartAfter
+	if len(i.data) > 0 {
+		cursor = i.data[0].GetID()
+	}
+
+	data, hasMore, err := i.fetchPage(ctx, "", cursor)
+	if err != nil {
+		return false, err
+	}
+	if len(data) == 0 {
+		i.hasMorePrev = false
+		i.pos = -1
+		return false, nil
+	}
+
+	i.data = data
+	i.pos = len(data)
+	i.hasMorePrev = hasMore
 	i.hasMoreNext = true
-	return i.fetchPage()
+	// The iterator only moves before the first element of a page fetched
+	// backward once there is nothing before it
+	i.after = new(string)
+	return hasMore, nil
 }
 
-func (i *Iterator) fetchPage() (bool, error) {
-	req, err := http.NewRequest(
+// fetchPage fetches the page of data located after or before the given
+// cursors. The options of the iterator are left untouched
+func (i *Iterator) fetchPage(ctx context.Context, startAfter, endBefore string) ([]Identifiable, bool, error) {
+	if i.client == nil {
+		// Iterators created from a slice have no page to fetch
+		return nil, false, nil
+	}
+
+	opt := *i.options
+	opt.StartAfter = startAfter
+	opt.EndBefore = endBefore
+
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+i.path,
+		i.client.baseURL()+i.path,
 		nil,
 	)
 	if err != nil {
-		return false, err
+		return nil, false, errors.NewNetworkError(err)
 	}
-	setupRequest(i.client, i.options, req)
+	setupRequest(i.client, &opt, req)
 
-	res, err := i.client.HTTPClient.Do(req)
+	call := i.call
+	call.Options = &opt
+	call.PageFetch = true
+	res, err := i.client.do(req, call)
 	if err != nil {
-		return false, err
+		return nil, false, errors.NewNetworkError(err)
 	}
 	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, false, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, false, errors.NewFromHTTPResponse(res, body)
+	}
+
+	payload := struct {
+		Success bool `json:"success"`
+	}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, false, errors.NewFromHTTPResponse(res, body)
+	}
+	if !payload.Success {
+		return nil, false, errors.NewFromHTTPResponse(res, body)
+	}
+
+	data, hasMore, err := i.decoder(body)
+	if err != nil {
+		return nil, false, errors.New(err, "", "")
+	}
+
+	return data, hasMore, nil
+}
+
+// NewSliceIterator returns an Iterator over the given elements, without
+// any other page to fetch. It can be used to mock the methods returning an
+// Iterator
+func NewSliceIterator(data ...Identifiable) *Iterator {
+	return &Iterator{
+		pos:     -1,
+		data:    data,
+		options: &Options{},
+	}
+}
+
+// TypedIterator is an Iterator over resources of type T, returned by the
+// ...Typed variants of the resource methods returning an Iterator. The
+// embedded Iterator can still be used directly
+type TypedIterator[T Identifiable] struct {
+	*Iterator
+}
+
+// NewTypedSliceIterator returns a TypedIterator over the given elements,
+// without any other page to fetch
+func NewTypedSliceIterator[T Identifiable](data ...T) *TypedIterator[T] {
+	l := make([]Identifiable, 0, len(data))
+	for _, d := range data {
+		l = append(l, d)
+	}
+
+	return &TypedIterator[T]{Iterator: NewSliceIterator(l...)}
+}
+
+// Current returns the current element
+func (i *TypedIterator[T]) Current() T {
+	v, _ := i.Get().(T)
+	return v
+}
+
+// Items returns the data currently fetched by the iterator
+func (i *TypedIterator[T]) Items() []T {
+	items := make([]T, 0, len(i.data))
+	for _, d := range i.data {
+		if v, ok := d.(T); ok {
+			items = append(items, v)
+		}
+	}
 
-	return i.decoder(res.Body, &i.data)
+	return items
 }
@@ -0,0 +1,32 @@
+//go:build go1.23
+
+package processout
+
+import (
+	"context"
+	"iter"
+)
+
+// Seq2 returns a sequence iterating over all the elements, fetching new
+// pages when needed. If a page could not be fetched, the sequence ends by
+// yielding the error
+func (i *TypedIterator[T]) Seq2() iter.Seq2[T, error] {
+	return i.Seq2WithContext(context.Background())
+}
+
+// Seq2WithContext returns a sequence iterating over all the elements,
+// fetching new pages with the given context when needed. If a page could
+// not be fetched, the sequence ends by yielding the error
+func (i *TypedIterator[T]) Seq2WithContext(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for i.NextWithContext(ctx) {
+			if !yield(i.Current(), nil) {
+				return
+			}
+		}
+		if err := i.Error(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+//go:build go1.23
+
+package processout
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTypedIteratorSeq2(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"has_more":false,"invoices":[{"id":"iv_1"},{"id":"iv_2"}]}`)
+	}))
+	defer srv.Close()
+
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+
+	it, err := p.NewInvoice().AllTyped()
+	if err != nil {
+		t.Fatalf("The invoices could not be listed: %s", err.Error())
+	}
+
+	ids := []string{}
+	for iv, err := range it.Seq2() {
+		if err != nil {
+			t.Fatalf("There shouldn't have been any error, but got %s", err.Error())
+		}
+		ids = append(ids, *iv.ID)
+	}
+	if len(ids) != 2 || ids[0] != "iv_1" || ids[1] != "iv_2" {
+		t.Errorf("The invoices should have been iv_1 and iv_2, but got %v", ids)
+	}
+}
@@ -0,0 +1,249 @@
+package processout
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/processout.v4/errors"
+)
+
+// redacted replaces the values masked by a Redactor
+const redacted = "[redacted]"
+
+var (
+	// panLikePattern matches the digit runs that may be card numbers, with
+	// or without separators
+	panLikePattern = regexp.MustCompile(`\d(?:[ -]?\d){12,18}`)
+	// gatewayRequestPattern matches the gateway requests, which embed the
+	// card data sent to the payment gateways
+	gatewayRequestPattern = regexp.MustCompile(`gway_req_[A-Za-z0-9+/=_-]*`)
+
+	// DefaultRedactor is the redactor used by the clients whose LogOptions
+	// don't set one
+	DefaultRedactor = &Redactor{
+		Headers: []string{
+			"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie",
+			"X-ProcessOut-Signature",
+		},
+		Fields: []string{
+			"number", "card_number", "cvc", "cvc2", "cvv", "cvv2", "exp_month",
+			"exp_year", "expiry", "email", "phone", "phone_number",
+			"legal_document", "password", "secret", "project_secret",
+			"private_key",
+		},
+	}
+)
+
+// Redactor masks the secrets, card data and personal information from the
+// logged requests and responses. Besides the headers and JSON fields it
+// lists, it always masks the digit runs that look like card numbers and
+// the gateway requests, prefixed with gway_req_
+type Redactor struct {
+	// Headers are the names of the headers whose values are masked
+	Headers []string
+	// Fields are the names of the JSON fields whose values are masked,
+	// whatever their type and wherever they are in the body. The names are
+	// case insensitive
+	Fields []string
+}
+
+// RedactHeader returns a copy of the header with the values of the masked
+// headers redacted
+func (r *Redactor) RedactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range r.Headers {
+		if _, ok := h[http.CanonicalHeaderKey(name)]; ok {
+			h.Set(name, redacted)
+		}
+	}
+
+	return h
+}
+
+// RedactString masks the card numbers and gateway requests of the string
+func (r *Redactor) RedactString(s string) string {
+	s = gatewayRequestPattern.ReplaceAllString(s, "gway_req_"+redacted)
+	return panLikePattern.ReplaceAllString(s, redacted)
+}
+
+// RedactBody returns the body with its sensitive data redacted. The values
+// of the masked fields of JSON bodies are redacted, and so are the card
+// numbers and gateway requests of any body
+func (r *Redactor) RedactBody(b []byte) string {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return r.RedactString(string(b))
+	}
+
+	b, err := json.Marshal(r.redactValue(v))
+	if err != nil {
+		return redacted
+	}
+	return string(b)
+}
+
+func (r *Redactor) redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if r.masked(k) && e != nil {
+				v[k] = redacted
+				continue
+			}
+			v[k] = r.redactValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = r.redactValue(e)
+		}
+	case string:
+		return r.RedactString(v)
+	case json.Number:
+		if panLikePattern.MatchString(v.String()) {
+			return redacted
+		}
+	}
+
+	return v
+}
+
+// masked returns whether or not the values of the JSON field are masked
+func (r *Redactor) masked(field string) bool {
+	for _, f := range r.Fields {
+		if strings.EqualFold(f, field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReplaceAttr redacts the string attributes of log records. It can be set
+// as the ReplaceAttr of the slog.HandlerOptions of the handlers logging
+// data that may come from the API, beyond the logs of the client
+func (r *Redactor) ReplaceAttr(groups []string, a slog.Attr) slog.Attr {
+	if r.masked(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+	if a.Value.Kind() == slog.KindString {
+		return slog.String(a.Key, r.RedactString(a.Value.String()))
+	}
+
+	return a
+}
+
+// LogOptions configures the logs of the API calls made by a client
+type LogOptions struct {
+	// SuccessLevel is the level of the logs of the successful calls.
+	// slog.LevelDebug is used when nil
+	SuccessLevel slog.Leveler
+	// FailureLevel is the level of the logs of the failed calls, including
+	// the declined payments. slog.LevelWarn is used when nil
+	FailureLevel slog.Leveler
+	// Details adds the headers and bodies of the requests and responses to
+	// the logs, once redacted
+	Details bool
+	// Redactor masks the sensitive data of the logged headers and bodies.
+	// DefaultRedactor is used when nil
+	Redactor *Redactor
+}
+
+// logCall logs a summary of the attempt of the call, if the client has a
+// Logger
+func (c *ProcessOut) logCall(call *Call, req *http.Request, start time.Time, res *http.Response, err error) {
+	if c.Logger == nil {
+		return
+	}
+
+	opt := c.LogOptions
+	level := slog.LevelDebug
+	if opt.SuccessLevel != nil {
+		level = opt.SuccessLevel.Level()
+	}
+	failed := err != nil || res == nil || res.StatusCode >= 400
+	if failed {
+		level = slog.LevelWarn
+		if opt.FailureLevel != nil {
+			level = opt.FailureLevel.Level()
+		}
+	}
+	ctx := req.Context()
+	if !c.Logger.Enabled(ctx, level) {
+		return
+	}
+	redactor := opt.Redactor
+	if redactor == nil {
+		redactor = DefaultRedactor
+	}
+
+	attrs := []slog.Attr{
+		slog.String("resource", call.Resource),
+		slog.String("method", call.Method),
+		slog.Int("attempt", call.Attempt),
+		slog.String("http_method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if call.ResourceID != "" {
+		attrs = append(attrs, slog.String("resource_id", call.ResourceID))
+	}
+	if key := req.Header.Get("Idempotency-Key"); key != "" {
+		attrs = append(attrs, slog.String("idempotency_key", key))
+	}
+	if opt.Details {
+		attrs = append(attrs,
+			slog.Any("request_header", redactor.RedactHeader(req.Header)),
+			slog.String("request_body", redactor.RedactBody(requestBody(req))))
+	}
+
+	msg := "ProcessOut API call"
+	if err != nil || res == nil {
+		msg = "ProcessOut API call failed"
+		if err != nil {
+			attrs = append(attrs, slog.String("error", redactor.RedactString(err.Error())))
+		}
+		c.Logger.LogAttrs(ctx, level, msg, attrs...)
+		return
+	}
+
+	attrs = append(attrs, slog.Int("status", res.StatusCode))
+	if id := errors.RequestID(res); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	if failed {
+		msg = "ProcessOut API call failed"
+		if code := ResponseErrorCode(res); code != "" {
+			attrs = append(attrs, slog.String("error_code", code))
+		}
+	}
+	if opt.Details {
+		attrs = append(attrs,
+			slog.Any("response_header", redactor.RedactHeader(res.Header)),
+			slog.String("response_body", redactor.RedactBody(responseBody(res))))
+	}
+	c.Logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// requestBody returns a copy of the body of the request, leaving the
+// request untouched
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	b, _ := io.ReadAll(body)
+	return b
+}
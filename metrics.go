@@ -0,0 +1,68 @@
+package processout
+
+import (
+	"net/http"
+	"time"
+
+	"gopkg.in/processout.v4/errors"
+)
+
+// CallMetrics describes an attempt of an API call, once completed
+type CallMetrics struct {
+	// Resource is the name of the resource the call was made on, such as
+	// Invoice
+	Resource string
+	// Method is the name of the resource method, such as Capture
+	Method string
+	// Attempt is the attempt number of the request, starting at 1
+	Attempt int
+	// PageFetch is true when the call was made by an Iterator fetching a
+	// new page
+	PageFetch bool
+	// StatusCode is the HTTP status code of the response, or 0 if no
+	// response was received
+	StatusCode int
+	// ErrorCode is the error code of the failed calls, such as
+	// card.declined, or processout.network-error when no response was
+	// received
+	ErrorCode string
+	// Duration is the time it took to receive the response
+	Duration time.Duration
+}
+
+// Failed returns whether or not the call failed, either because no
+// response was received or because of its status code
+func (m CallMetrics) Failed() bool {
+	return m.StatusCode == 0 || m.StatusCode >= 400
+}
+
+// MetricsRecorder records the metrics of the API calls made by a client,
+// such as their count, latency and errors. See the processoutprom and
+// processoutexpvar subpackages for ready implementations
+type MetricsRecorder interface {
+	// RecordCall is called once every attempt of an API call completes
+	RecordCall(m CallMetrics)
+}
+
+// recordMetrics records the metrics of the attempt of the call, if the
+// client has a MetricsRecorder
+func (c *ProcessOut) recordMetrics(call *Call, start time.Time, res *http.Response, err error) {
+	if c.Metrics == nil {
+		return
+	}
+
+	m := CallMetrics{
+		Resource:  call.Resource,
+		Method:    call.Method,
+		Attempt:   call.Attempt,
+		PageFetch: call.PageFetch,
+		Duration:  time.Since(start),
+	}
+	if err != nil || res == nil {
+		m.ErrorCode = errors.CodeNetworkError
+	} else {
+		m.StatusCode = res.StatusCode
+		m.ErrorCode = ResponseErrorCode(res)
+	}
+	c.Metrics.RecordCall(m)
+}
@@ -0,0 +1,106 @@
+package processout
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// Call describes an API call made by a resource method, such as
+// Invoice.Capture
+type Call struct {
+	// Resource is the name of the resource the call is made on, such as
+	// Invoice
+	Resource string
+	// Method is the name of the resource method, such as Capture
+	Method string
+	// ResourceID is the ID of the resource the call is made on, such as the
+	// invoice ID of Invoice.Capture or the IIN of CardInformation.Fetch. It
+	// is empty for the calls that aren't made on an existing resource, such
+	// as Create, All or the listings of the resources of a parent, like
+	// Refund.FetchTransactionRefunds
+	ResourceID string
+	// Options is the options the call was made with
+	Options *Options
+	// Attempt is the attempt number of the request, starting at 1
+	Attempt int
+	// PageFetch is true when the call was made by an Iterator fetching a
+	// new page
+	PageFetch bool
+}
+
+// RoundTripFunc sends the request of an API call and returns its response
+type RoundTripFunc func(call *Call, req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc to add behavior around API calls, such as
+// injecting headers, logging or measuring latency. A middleware may also
+// short-circuit the call by returning a response without calling next, or
+// by returning an error. A ProcessOut error, such as one built with
+// errors.New, is returned as is to the caller and isn't retried, while any
+// other error is returned as a retryable NetworkError
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends the given middlewares to the client middleware chain. The
+// first middleware registered is the outermost one
+func (c *ProcessOut) Use(middlewares ...Middleware) {
+	c.Middlewares = append(c.Middlewares, middlewares...)
+}
+
+// roundTripper returns the RoundTripFunc wrapping the client HTTPClient
+// with its middlewares
+func (c *ProcessOut) roundTripper() RoundTripFunc {
+	var rt RoundTripFunc = func(call *Call, req *http.Request) (*http.Response, error) {
+		return c.HTTPClient.Do(req)
+	}
+	for i := len(c.Middlewares) - 1; i >= 0; i-- {
+		rt = c.Middlewares[i](rt)
+	}
+
+	return rt
+}
+
+// ResponseErrorCode returns the error code, or error_type, of a failed API
+// response, such as card.declined, or an empty string if it has none. The
+// body of the response is read and replaced so that the resource method
+// can still decode it. Responses with a status code below 400 are left
+// untouched. It is meant to be used by middlewares
+func ResponseErrorCode(res *http.Response) string {
+	if res == nil || res.Body == nil || res.StatusCode < 400 {
+		return ""
+	}
+
+	body := responseBody(res)
+	payload := struct {
+		Code string `json:"error_type"`
+	}{}
+	json.Unmarshal(body, &payload)
+	return payload.Code
+}
+
+// responseBody reads the body of the response, and replaces it so that the
+// resource method can still decode it
+func responseBody(res *http.Response) []byte {
+	if res.Body == nil {
+		return nil
+	}
+
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		res.Body = io.NopCloser(io.MultiReader(bytes.NewReader(b), errorReader{err}))
+		return b
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+	return b
+}
+
+// errorReader is an io.Reader always failing with the same error
+type errorReader struct {
+	err error
+}
+
+// Read implements io.Reader
+func (r errorReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
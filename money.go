@@ -0,0 +1,384 @@
+package processout
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"gopkg.in/processout.v4/errors"
+)
+
+// amountPattern matches the decimal representation of the amounts used by
+// the API, such as 10.50 or -3
+var amountPattern = regexp.MustCompile(`^([+-]?)(\d+)(?:\.(\d+))?$`)
+
+// Amount is an exact decimal amount, such as the amounts sent and returned
+// by the API. The zero value is 0. Amounts are immutable, and must be
+// compared using Cmp or Equal rather than ==
+type Amount struct {
+	// unscaled is the amount multiplied by 10^scale. nil is 0
+	unscaled *big.Int
+	scale    int32
+}
+
+// NewAmount returns the amount unscaled / 10^scale, such as 10.50 for
+// NewAmount(1050, 2)
+func NewAmount(unscaled int64, scale int32) Amount {
+	if scale < 0 {
+		return Amount{unscaled: new(big.Int).Mul(big.NewInt(unscaled), pow10(-scale))}
+	}
+
+	return Amount{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// ParseAmount parses the decimal representation of an amount, such as the
+// ones returned by the API. The number of decimals of the representation is
+// kept, so that 10.50 is formatted back as 10.50
+func ParseAmount(s string) (Amount, error) {
+	m := amountPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Amount{}, errors.New(nil, "", fmt.Sprintf("%q is not a valid amount", s))
+	}
+
+	u, _ := new(big.Int).SetString(m[2]+m[3], 10)
+	if m[1] == "-" {
+		u.Neg(u)
+	}
+	return Amount{unscaled: u, scale: int32(len(m[3]))}, nil
+}
+
+// MustParseAmount is like ParseAmount but panics if the amount is invalid
+func MustParseAmount(s string) Amount {
+	a, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func (a Amount) int() *big.Int {
+	if a.unscaled == nil {
+		return new(big.Int)
+	}
+
+	return a.unscaled
+}
+
+// rescale returns the unscaled value of the amount at the given scale,
+// which must not be lower than the scale of the amount
+func (a Amount) rescale(scale int32) *big.Int {
+	if scale == a.scale {
+		return a.int()
+	}
+
+	return new(big.Int).Mul(a.int(), pow10(scale-a.scale))
+}
+
+// align returns the unscaled values of both amounts at the same scale
+func align(a, b Amount) (*big.Int, *big.Int, int32) {
+	scale := max(a.scale, b.scale)
+	return a.rescale(scale), b.rescale(scale), scale
+}
+
+// Scale returns the number of decimals of the amount
+func (a Amount) Scale() int32 {
+	return a.scale
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of the amount
+func (a Amount) Sign() int {
+	return a.int().Sign()
+}
+
+// IsZero returns whether or not the amount is 0
+func (a Amount) IsZero() bool {
+	return a.Sign() == 0
+}
+
+// Add returns a + b
+func (a Amount) Add(b Amount) Amount {
+	x, y, scale := align(a, b)
+	return Amount{unscaled: new(big.Int).Add(x, y), scale: scale}
+}
+
+// Sub returns a - b
+func (a Amount) Sub(b Amount) Amount {
+	x, y, scale := align(a, b)
+	return Amount{unscaled: new(big.Int).Sub(x, y), scale: scale}
+}
+
+// Mul returns a * n
+func (a Amount) Mul(n int64) Amount {
+	return Amount{unscaled: new(big.Int).Mul(a.int(), big.NewInt(n)), scale: a.scale}
+}
+
+// Neg returns -a
+func (a Amount) Neg() Amount {
+	return Amount{unscaled: new(big.Int).Neg(a.int()), scale: a.scale}
+}
+
+// Abs returns |a|
+func (a Amount) Abs() Amount {
+	return Amount{unscaled: new(big.Int).Abs(a.int()), scale: a.scale}
+}
+
+// Cmp compares the amounts, and returns -1 if a < b, 0 if a == b and 1 if
+// a > b. The number of decimals doesn't matter, 10.5 is equal to 10.50
+func (a Amount) Cmp(b Amount) int {
+	x, y, _ := align(a, b)
+	return x.Cmp(y)
+}
+
+// Equal returns whether or not the amounts are equal
+func (a Amount) Equal(b Amount) bool {
+	return a.Cmp(b) == 0
+}
+
+// Round returns the amount rounded to the given number of decimals, half
+// away from zero. The amount is returned with the given number of decimals
+// even if it already had less
+func (a Amount) Round(scale int32) Amount {
+	if scale >= a.scale {
+		return Amount{unscaled: a.rescale(scale), scale: scale}
+	}
+
+	d := pow10(a.scale - scale)
+	q, r := new(big.Int).QuoRem(a.int(), d, new(big.Int))
+	if r.Abs(r).Lsh(r, 1).Cmp(d) >= 0 {
+		q.Add(q, big.NewInt(int64(a.Sign())))
+	}
+	return Amount{unscaled: q, scale: scale}
+}
+
+// Rat returns the amount as a big.Rat
+func (a Amount) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(a.int(), pow10(a.scale))
+}
+
+// String returns the decimal representation of the amount, with its number
+// of decimals, as expected by the API
+func (a Amount) String() string {
+	s := new(big.Int).Abs(a.int()).String()
+	if a.scale > 0 {
+		if pad := int(a.scale) + 1 - len(s); pad > 0 {
+			s = strings.Repeat("0", pad) + s
+		}
+		s = s[:len(s)-int(a.scale)] + "." + s[len(s)-int(a.scale):]
+	}
+	if a.Sign() < 0 {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// MarshalJSON encodes the amount as a JSON string, as the API does
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON decodes the amount from a JSON string or number. null
+// leaves the amount untouched
+func (a *Amount) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+	}
+
+	v, err := ParseAmount(s)
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// amountOf parses the amount of a resource field, nil being 0
+func amountOf(s *string) (Amount, error) {
+	if s == nil {
+		return Amount{}, nil
+	}
+
+	return ParseAmount(*s)
+}
+
+// MinorUnits returns the number of decimals of the amounts in the given
+// ISO 4217 currency, such as 2 for USD, 0 for JPY and 3 for KWD. Unknown
+// currencies are assumed to have 2
+func MinorUnits(currency string) int32 {
+	if c, ok := LookupCurrency(currency); ok {
+		return c.MinorUnits
+	}
+
+	return 2
+}
+
+// Money is an exact amount in a currency. Its currency is empty when it
+// isn't known, such as for the amounts of resources that don't have a
+// currency. The Money accessors of the resources, such as
+// Transaction.AmountMoney, return 0 for the amounts that aren't set, and an
+// error for the amounts that can't be parsed
+type Money struct {
+	Amount   Amount
+	Currency string
+}
+
+// NewMoney returns the amount in the given ISO 4217 currency
+func NewMoney(amount Amount, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// ParseMoney parses the decimal representation of an amount in the given
+// currency
+func ParseMoney(amount, currency string) (Money, error) {
+	a, err := ParseAmount(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(a, currency), nil
+}
+
+// MoneyFromMinorUnits returns the amount in the given currency from its
+// number of minor units, such as 1050 cents for 10.50 USD or 1050 yens for
+// 1050 JPY
+func MoneyFromMinorUnits(units int64, currency string) Money {
+	return NewMoney(NewAmount(units, MinorUnits(currency)), currency)
+}
+
+// moneyOf parses the amount of a resource field in the given currency, nil
+// being 0
+func moneyOf(amount, currency *string) (Money, error) {
+	a, err := amountOf(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return NewMoney(a, ToString(currency)), nil
+}
+
+// MinorUnits returns the amount as a number of minor units of its
+// currency, such as cents. An error is returned if the amount has more
+// decimals than its currency allows, or if it doesn't fit in an int64
+func (m Money) MinorUnits() (int64, error) {
+	scale := MinorUnits(m.Currency)
+	r := m.Amount.Round(scale)
+	if !r.Equal(m.Amount) {
+		return 0, errors.New(nil, "", fmt.Sprintf(
+			"The amount %s has more decimals than allowed in %s", m.Amount, m.Currency))
+	}
+	if !r.int().IsInt64() {
+		return 0, errors.New(nil, "", fmt.Sprintf(
+			"The amount %s is too large to be expressed in minor units", m.Amount))
+	}
+
+	return r.int().Int64(), nil
+}
+
+// Round returns the amount rounded to the number of decimals of its
+// currency, half away from zero
+func (m Money) Round() Money {
+	return Money{Amount: m.Amount.Round(MinorUnits(m.Currency)), Currency: m.Currency}
+}
+
+// check returns an error if the currencies of the amounts differ
+func (m Money) check(o Money) error {
+	if m.Currency != o.Currency {
+		return errors.New(nil, "", fmt.Sprintf(
+			"The currencies %s and %s of the amounts don't match", m.Currency, o.Currency))
+	}
+
+	return nil
+}
+
+// Add returns m + o. An error is returned if their currencies differ
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: m.Amount.Add(o.Amount), Currency: m.Currency}, nil
+}
+
+// Sub returns m - o. An error is returned if their currencies differ
+func (m Money) Sub(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: m.Amount.Sub(o.Amount), Currency: m.Currency}, nil
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return Money{Amount: m.Amount.Neg(), Currency: m.Currency}
+}
+
+// Cmp compares the amounts, and returns -1 if m < o, 0 if m == o and 1 if
+// m > o. An error is returned if their currencies differ
+func (m Money) Cmp(o Money) (int, error) {
+	if err := m.check(o); err != nil {
+		return 0, err
+	}
+
+	return m.Amount.Cmp(o.Amount), nil
+}
+
+// Equal returns whether or not the amounts and their currencies are equal
+func (m Money) Equal(o Money) bool {
+	return m.Currency == o.Currency && m.Amount.Equal(o.Amount)
+}
+
+// IsZero returns whether or not the amount is 0
+func (m Money) IsZero() bool {
+	return m.Amount.IsZero()
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of the amount
+func (m Money) Sign() int {
+	return m.Amount.Sign()
+}
+
+// AmountString returns the decimal representation of the amount, with at
+// least the number of decimals of its currency, such as 10.50 for USD or
+// 1050 for JPY
+func (m Money) AmountString() string {
+	if scale := MinorUnits(m.Currency); m.Amount.scale < scale {
+		return m.Amount.Round(scale).String()
+	}
+
+	return m.Amount.String()
+}
+
+// String returns the amount followed by its currency, such as 10.50 USD
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.AmountString()
+	}
+
+	return m.AmountString() + " " + m.Currency
+}
+
+// MarshalJSON encodes the amount as a JSON string, as the API does. The
+// currency is sent separately to the API, and isn't encoded
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.AmountString())
+}
+
+// UnmarshalJSON decodes the amount from a JSON string or number, keeping
+// the currency of the money
+func (m *Money) UnmarshalJSON(b []byte) error {
+	return m.Amount.UnmarshalJSON(b)
+}
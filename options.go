@@ -0,0 +1,140 @@
+package processout
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+// Option configures a client created with NewWithOptions
+type Option func(*ProcessOut)
+
+// NewWithOptions creates a new client configured with the given options.
+// Its credentials are read from the PROCESSOUT_PROJECT_ID and
+// PROCESSOUT_PROJECT_SECRET environment variables on every request, unless
+// WithCredentials or WithCredentialsProvider is given:
+//
+//	creds, err := processout.NewFileCredentials(
+//		"/var/run/secrets/processout/project-id",
+//		"/var/run/secrets/processout/project-secret",
+//	)
+//	if err != nil {
+//		return err
+//	}
+//	client := processout.NewWithOptions(
+//		processout.WithCredentialsProvider(creds),
+//		processout.WithRetryPolicy(processout.DefaultRetryPolicy),
+//	)
+func NewWithOptions(opts ...Option) *ProcessOut {
+	p := &ProcessOut{
+		APIVersion:  RequestAPIVersion,
+		HTTPClient:  DefaultClient,
+		credentials: EnvCredentials{},
+	}
+	for _, o := range opts {
+		o(p)
+	}
+
+	return p
+}
+
+// WithHTTPClient sets the HTTP client sending the requests. DefaultClient
+// is used otherwise
+func WithHTTPClient(c *http.Client) Option {
+	return func(p *ProcessOut) {
+		p.HTTPClient = c
+	}
+}
+
+// WithBaseURL sets the URL where the requests are sent, such as the URL of
+// a proxy or of a fake API in tests. Host is used otherwise
+func WithBaseURL(u string) Option {
+	return func(p *ProcessOut) {
+		p.BaseURL = u
+	}
+}
+
+// WithAPIVersion sets the version of the API used by the requests.
+// RequestAPIVersion is used otherwise
+func WithAPIVersion(v string) Option {
+	return func(p *ProcessOut) {
+		p.APIVersion = v
+	}
+}
+
+// WithUserAgent sets the User-Agent of the requests
+func WithUserAgent(ua string) Option {
+	return func(p *ProcessOut) {
+		p.UserAgent = ua
+	}
+}
+
+// WithCredentials sets static credentials, like New does
+func WithCredentials(projectID, projectSecret string) Option {
+	return func(p *ProcessOut) {
+		p.projectID = projectID
+		p.projectSecret = projectSecret
+		p.credentials = nil
+	}
+}
+
+// WithCredentialsProvider sets the provider of the credentials of the
+// requests, called before every request so that rotated credentials are
+// picked up live. See EnvCredentials and FileCredentials
+func WithCredentialsProvider(c CredentialsProvider) Option {
+	return func(p *ProcessOut) {
+		p.credentials = c
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests
+func WithRetryPolicy(r *RetryPolicy) Option {
+	return func(p *ProcessOut) {
+		p.RetryPolicy = r
+	}
+}
+
+// WithMiddlewares appends the given middlewares to the middleware chain
+func WithMiddlewares(middlewares ...Middleware) Option {
+	return func(p *ProcessOut) {
+		p.Use(middlewares...)
+	}
+}
+
+// WithValidators appends the given hooks to the validators of the
+// resources
+func WithValidators(validators ...ValidateFunc) Option {
+	return func(p *ProcessOut) {
+		p.UseValidators(validators...)
+	}
+}
+
+// WithValidation enables the Validate method of the resources, such as the
+// checks of their currency. See EnableValidation
+func WithValidation() Option {
+	return func(p *ProcessOut) {
+		p.EnableValidation = true
+	}
+}
+
+// WithMetrics sets the recorder of the metrics of the API calls
+func WithMetrics(m MetricsRecorder) Option {
+	return func(p *ProcessOut) {
+		p.Metrics = m
+	}
+}
+
+// WithLogger sets the logger of the API calls, configured with the given
+// options
+func WithLogger(l *slog.Logger, opts LogOptions) Option {
+	return func(p *ProcessOut) {
+		p.Logger = l
+		p.LogOptions = opts
+	}
+}
+
+// WithLimiter sets the limiter throttling the requests
+func WithLimiter(l *Limiter) Option {
+	return func(p *ProcessOut) {
+		p.Limiter = l
+	}
+}
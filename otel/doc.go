@@ -0,0 +1,25 @@
+// Package otel traces the ProcessOut API calls with OpenTelemetry. Every
+// resource method, such as Invoice.Capture, and every page fetched by an
+// Iterator is recorded as a client span named after it, such as
+// processout.Invoice.Capture, child of the span found in the context the
+// method was called with:
+//
+//	client := processout.New("<project-id>", "<project-secret>")
+//	otel.Instrument(client)
+//
+//	iv, err := client.NewInvoice().FindWithContext(ctx, "iv_...")
+//
+// A span is recorded for every attempt of the calls retried by the client
+// RetryPolicy. The trace context is propagated to the HTTP requests, so
+// that the spans of an instrumented HTTPClient are children of the
+// ProcessOut ones.
+//
+// The spans only record the identifiers of the calls, such as the resource
+// ID, the idempotency key and the error code, and never their body. Card
+// data, customer details and the project credentials are never recorded.
+//
+// The package is a module of its own, so that the OpenTelemetry
+// dependencies aren't required by the clients not using it:
+//
+//	go get gopkg.in/processout.v4/otel
+package otel
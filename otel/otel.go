@@ -0,0 +1,159 @@
+package otel
+
+import (
+	"net/http"
+	"strconv"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"gopkg.in/processout.v4"
+)
+
+// ScopeName is the instrumentation scope of the tracer creating the spans
+const ScopeName = "gopkg.in/processout.v4/otel"
+
+// Attribute keys of the spans, along with the standard HTTP ones
+const (
+	// ResourceKey is the name of the resource the call is made on, such as
+	// Invoice
+	ResourceKey = attribute.Key("processout.resource")
+	// MethodKey is the name of the resource method, such as Capture
+	MethodKey = attribute.Key("processout.method")
+	// ResourceIDKey is the ID of the resource the call is made on, such as
+	// the IIN of CardInformation.Fetch. It is only set on the spans of the
+	// calls having a ResourceID, see processout.Call
+	ResourceIDKey = attribute.Key("processout.resource_id")
+	// APIVersionKey is the version of the API the call is made with
+	APIVersionKey = attribute.Key("processout.api_version")
+	// ErrorCodeKey is the error code of the failed calls, such as
+	// card.declined
+	ErrorCodeKey = attribute.Key("processout.error_code")
+	// IdempotencyKeyKey is the idempotency key the call is made with, if any
+	IdempotencyKeyKey = attribute.Key("processout.idempotency_key")
+	// RetryAttemptKey is the attempt number of the call, starting at 1
+	RetryAttemptKey = attribute.Key("processout.retry.attempt")
+	// PageFetchKey is true when the call is an Iterator fetching a page
+	PageFetchKey = attribute.Key("processout.page_fetch")
+)
+
+// config is the configuration of the instrumentation
+type config struct {
+	provider    trace.TracerProvider
+	propagators propagation.TextMapPropagator
+}
+
+// Option configures the instrumentation
+type Option func(*config)
+
+// WithTracerProvider sets the provider of the tracer creating the spans.
+// The global provider is used by default
+func WithTracerProvider(p trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = p
+	}
+}
+
+// WithPropagators sets the propagators injecting the trace context in the
+// HTTP requests. The global propagators are used by default
+func WithPropagators(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = p
+	}
+}
+
+// SpanName returns the name of the span of the call, such as
+// processout.Invoice.Capture
+func SpanName(call *processout.Call) string {
+	return "processout." + call.Resource + "." + call.Method
+}
+
+// Instrument adds the tracing middleware to the client
+func Instrument(c *processout.ProcessOut, opts ...Option) {
+	c.Use(Middleware(opts...))
+}
+
+// Middleware returns a middleware recording a span for every attempt of
+// the API calls going through it
+func Middleware(opts ...Option) processout.Middleware {
+	c := &config{}
+	for _, o := range opts {
+		o(c)
+	}
+	if c.provider == nil {
+		c.provider = otelapi.GetTracerProvider()
+	}
+	if c.propagators == nil {
+		c.propagators = otelapi.GetTextMapPropagator()
+	}
+	tracer := c.provider.Tracer(ScopeName)
+
+	return func(next processout.RoundTripFunc) processout.RoundTripFunc {
+		return func(call *processout.Call, req *http.Request) (*http.Response, error) {
+			ctx, span := tracer.Start(req.Context(), SpanName(call),
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(callAttributes(call, req)...),
+			)
+			defer span.End()
+
+			// The request is cloned so that the trace context of an attempt
+			// isn't shared with the others
+			req = req.Clone(ctx)
+			c.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			res, err := next(call, req)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return res, err
+			}
+
+			span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+			if res.StatusCode >= 400 {
+				code := processout.ResponseErrorCode(res)
+				if code != "" {
+					span.SetAttributes(ErrorCodeKey.String(code))
+				}
+				span.SetStatus(codes.Error, errorDescription(res, code))
+			}
+			return res, nil
+		}
+	}
+}
+
+// callAttributes returns the attributes describing the call. Only the
+// identifiers of the call are recorded, never its body
+func callAttributes(call *processout.Call, req *http.Request) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		ResourceKey.String(call.Resource),
+		MethodKey.String(call.Method),
+		APIVersionKey.String(req.Header.Get("API-Version")),
+		RetryAttemptKey.Int(call.Attempt),
+		attribute.String("http.request.method", req.Method),
+		attribute.String("server.address", req.URL.Hostname()),
+	}
+	if call.ResourceID != "" {
+		attrs = append(attrs, ResourceIDKey.String(call.ResourceID))
+	}
+	if key := req.Header.Get("Idempotency-Key"); key != "" {
+		attrs = append(attrs, IdempotencyKeyKey.String(key))
+	}
+	if call.PageFetch {
+		attrs = append(attrs, PageFetchKey.Bool(true))
+	}
+
+	return attrs
+}
+
+// errorDescription returns the description of the status of the span of a
+// failed call
+func errorDescription(res *http.Response, code string) string {
+	if code != "" {
+		return code
+	}
+
+	return strconv.Itoa(res.StatusCode) + " " + http.StatusText(res.StatusCode)
+}
@@ -0,0 +1,108 @@
+package otel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"gopkg.in/processout.v4"
+	"gopkg.in/processout.v4/errors"
+	"gopkg.in/processout.v4/processouttest"
+)
+
+func attrs(s sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	m := map[attribute.Key]attribute.Value{}
+	for _, kv := range s.Attributes() {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+func TestMiddleware(t *testing.T) {
+	srv := processouttest.NewServer()
+	defer srv.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	c := srv.Client()
+	Instrument(c, WithTracerProvider(provider), WithPropagators(propagation.TraceContext{}))
+
+	traceparents := []string{}
+	c.Use(func(next processout.RoundTripFunc) processout.RoundTripFunc {
+		return func(call *processout.Call, req *http.Request) (*http.Response, error) {
+			traceparents = append(traceparents, req.Header.Get("Traceparent"))
+			return next(call, req)
+		}
+	})
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "checkout")
+	var iv *processout.Invoice
+	for i := 0; i < 2; i++ {
+		var err error
+		iv, err = c.NewInvoice(&processout.Invoice{
+			Name:     processout.String("Test invoice"),
+			Amount:   processout.String(processouttest.AmountDeclined),
+			Currency: processout.String("USD"),
+		}).CreateWithContext(ctx)
+		if err != nil {
+			t.Fatalf("The invoice could not be created: %s", err.Error())
+		}
+	}
+	_, err := iv.CaptureWithContext(ctx, processouttest.SourceApproved, processout.InvoiceCaptureParameters{
+		Options: &processout.Options{IdempotencyKey: "capture-1"},
+	})
+	if _, ok := err.(*errors.ValidationError); !ok {
+		t.Fatalf("The capture should have been declined, got %v", err)
+	}
+	it, err := c.NewInvoice().AllTypedWithContext(ctx, processout.InvoiceAllParameters{
+		Options: &processout.Options{Limit: 1},
+	})
+	if err != nil {
+		t.Fatalf("The invoices could not be listed: %s", err.Error())
+	}
+	for it.NextWithContext(ctx) {
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 6 {
+		t.Fatalf("6 spans should have been recorded, got %d", len(spans))
+	}
+	for i, s := range spans[:5] {
+		if s.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("The span %s should be a child of the parent span", s.Name())
+		}
+		if traceparents[i] == "" {
+			t.Errorf("The trace context should have been propagated to the request of %s", s.Name())
+		}
+		for _, kv := range s.Attributes() {
+			if kv.Value.AsString() == srv.ProjectSecret {
+				t.Errorf("The span %s should not record the project secret", s.Name())
+			}
+		}
+	}
+
+	capture := spans[2]
+	a := attrs(capture)
+	if capture.Name() != "processout.Invoice.Capture" || capture.Status().Code != codes.Error {
+		t.Errorf("The capture should have been recorded as failed, got %s %v", capture.Name(), capture.Status())
+	}
+	if a[ResourceIDKey].AsString() != *iv.ID || a[ErrorCodeKey].AsString() != errors.CodeCardDeclined ||
+		a[IdempotencyKeyKey].AsString() != "capture-1" || a[RetryAttemptKey].AsInt64() != 1 ||
+		a[APIVersionKey].AsString() != c.APIVersion {
+		t.Errorf("Unexpected capture attributes %v", capture.Attributes())
+	}
+
+	if spans[3].Name() != "processout.Invoice.All" || attrs(spans[3])[PageFetchKey].AsBool() {
+		t.Errorf("The first page should be fetched by Invoice.All, got %s", spans[3].Name())
+	}
+	if spans[4].Name() != "processout.Invoice.All" || !attrs(spans[4])[PageFetchKey].AsBool() {
+		t.Errorf("The second page should be recorded as a page fetch, got %s", spans[4].Name())
+	}
+}
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -120,6 +121,11 @@ type PayoutFetchItemsParameters struct {
 
 // FetchItems allows you to get all the items linked to the payout.
 func (s Payout) FetchItems(options ...PayoutFetchItemsParameters) (*Iterator, error) {
+	return s.FetchItemsWithContext(context.Background(), options...)
+}
+
+// FetchItemsWithContext allows you to get all the items linked to the payout.
+func (s Payout) FetchItemsWithContext(ctx context.Context, options ...PayoutFetchItemsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewPayout() method to create a new Payout object")
 	}
@@ -158,9 +164,10 @@ func (s Payout) FetchItems(options ...PayoutFetchItemsParameters) (*Iterator, er
 
 	path := "/payouts/" + url.QueryEscape(*s.ID) + "/items"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -168,23 +175,31 @@ func (s Payout) FetchItems(options ...PayoutFetchItemsParameters) (*Iterator, er
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Payout",
+		Method:     "FetchItems",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -193,31 +208,53 @@ func (s Payout) FetchItems(options ...PayoutFetchItemsParameters) (*Iterator, er
 	for _, o := range payload.Items {
 		itemsList = append(itemsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	itemsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Payout",
+			Method:     "FetchItems",
+			ResourceID: *s.ID,
+		},
 		data:    itemsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"items"`
-				HasMore bool            `json:"has_more"`
+				Data    []*PayoutItem `json:"items"`
+				HasMore bool          `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return itemsIterator, nil
 }
 
+// FetchItemsTyped allows you to get all the items linked to the payout, through an iterator of *PayoutItem.
+func (s Payout) FetchItemsTyped(options ...PayoutFetchItemsParameters) (*TypedIterator[*PayoutItem], error) {
+	return s.FetchItemsTypedWithContext(context.Background(), options...)
+}
+
+// FetchItemsTypedWithContext allows you to get all the items linked to the payout, through an iterator of *PayoutItem.
+func (s Payout) FetchItemsTypedWithContext(ctx context.Context, options ...PayoutFetchItemsParameters) (*TypedIterator[*PayoutItem], error) {
+	it, err := s.FetchItemsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*PayoutItem]{Iterator: it}, nil
+}
+
 // PayoutAllParameters is the structure representing the
 // additional parameters used to call Payout.All
 type PayoutAllParameters struct {
@@ -227,6 +264,11 @@ type PayoutAllParameters struct {
 
 // All allows you to get all the payouts.
 func (s Payout) All(options ...PayoutAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the payouts.
+func (s Payout) AllWithContext(ctx context.Context, options ...PayoutAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewPayout() method to create a new Payout object")
 	}
@@ -265,9 +307,10 @@ func (s Payout) All(options ...PayoutAllParameters) (*Iterator, error) {
 
 	path := "/payouts"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -275,23 +318,30 @@ func (s Payout) All(options ...PayoutAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Payout",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -300,31 +350,52 @@ func (s Payout) All(options ...PayoutAllParameters) (*Iterator, error) {
 	for _, o := range payload.Payouts {
 		payoutsList = append(payoutsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	payoutsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Payout",
+			Method:   "All",
+		},
 		data:    payoutsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"payouts"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Payout `json:"payouts"`
+				HasMore bool      `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return payoutsIterator, nil
 }
 
+// AllTyped allows you to get all the payouts, through an iterator of *Payout.
+func (s Payout) AllTyped(options ...PayoutAllParameters) (*TypedIterator[*Payout], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the payouts, through an iterator of *Payout.
+func (s Payout) AllTypedWithContext(ctx context.Context, options ...PayoutAllParameters) (*TypedIterator[*Payout], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Payout]{Iterator: it}, nil
+}
+
 // PayoutFindParameters is the structure representing the
 // additional parameters used to call Payout.Find
 type PayoutFindParameters struct {
@@ -334,6 +405,11 @@ type PayoutFindParameters struct {
 
 // Find allows you to find a payout by its ID.
 func (s Payout) Find(payoutID string, options ...PayoutFindParameters) (*Payout, error) {
+	return s.FindWithContext(context.Background(), payoutID, options...)
+}
+
+// FindWithContext allows you to find a payout by its ID.
+func (s Payout) FindWithContext(ctx context.Context, payoutID string, options ...PayoutFindParameters) (*Payout, error) {
 	if s.client == nil {
 		panic("Please use the client.NewPayout() method to create a new Payout object")
 	}
@@ -371,9 +447,10 @@ func (s Payout) Find(payoutID string, options ...PayoutFindParameters) (*Payout,
 
 	path := "/payouts/" + url.QueryEscape(payoutID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -381,23 +458,31 @@ func (s Payout) Find(payoutID string, options ...PayoutFindParameters) (*Payout,
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Payout",
+		Method:     "Find",
+		ResourceID: payoutID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -406,6 +491,16 @@ func (s Payout) Find(payoutID string, options ...PayoutFindParameters) (*Payout,
 	return payload.Payout, nil
 }
 
+// AmountMoney returns the amount of the payout in its currency
+func (s *Payout) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, s.Currency)
+}
+
+// FeesMoney returns the fees of the payout in its currency
+func (s *Payout) FeesMoney() (Money, error) {
+	return moneyOf(s.Fees, s.Currency)
+}
+
 // dummyPayout is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
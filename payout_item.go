@@ -96,6 +96,28 @@ func (s *PayoutItem) Prefill(c *PayoutItem) *PayoutItem {
 	return s
 }
 
+// AmountMoney returns the amount of the payout item in the currency of its
+// payout, which is empty unless Payout was expanded
+func (s *PayoutItem) AmountMoney() (Money, error) {
+	var currency *string
+	if s.Payout != nil {
+		currency = s.Payout.Currency
+	}
+
+	return moneyOf(s.Amount, currency)
+}
+
+// FeesMoney returns the fees of the payout item in the currency of its
+// payout, which is empty unless Payout was expanded
+func (s *PayoutItem) FeesMoney() (Money, error) {
+	var currency *string
+	if s.Payout != nil {
+		currency = s.Payout.Currency
+	}
+
+	return moneyOf(s.Fees, currency)
+}
+
 // dummyPayoutItem is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -102,6 +103,11 @@ type PlanAllParameters struct {
 
 // All allows you to get all the plans.
 func (s Plan) All(options ...PlanAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the plans.
+func (s Plan) AllWithContext(ctx context.Context, options ...PlanAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewPlan() method to create a new Plan object")
 	}
@@ -140,9 +146,10 @@ func (s Plan) All(options ...PlanAllParameters) (*Iterator, error) {
 
 	path := "/plans"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -150,23 +157,30 @@ func (s Plan) All(options ...PlanAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Plan",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -175,31 +189,52 @@ func (s Plan) All(options ...PlanAllParameters) (*Iterator, error) {
 	for _, o := range payload.Plans {
 		plansList = append(plansList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	plansIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Plan",
+			Method:   "All",
+		},
 		data:    plansList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"plans"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Plan `json:"plans"`
+				HasMore bool    `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return plansIterator, nil
 }
 
+// AllTyped allows you to get all the plans, through an iterator of *Plan.
+func (s Plan) AllTyped(options ...PlanAllParameters) (*TypedIterator[*Plan], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the plans, through an iterator of *Plan.
+func (s Plan) AllTypedWithContext(ctx context.Context, options ...PlanAllParameters) (*TypedIterator[*Plan], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Plan]{Iterator: it}, nil
+}
+
 // PlanCreateParameters is the structure representing the
 // additional parameters used to call Plan.Create
 type PlanCreateParameters struct {
@@ -209,6 +244,11 @@ type PlanCreateParameters struct {
 
 // Create allows you to create a new plan.
 func (s Plan) Create(options ...PlanCreateParameters) (*Plan, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new plan.
+func (s Plan) CreateWithContext(ctx context.Context, options ...PlanCreateParameters) (*Plan, error) {
 	if s.client == nil {
 		panic("Please use the client.NewPlan() method to create a new Plan object")
 	}
@@ -224,6 +264,13 @@ func (s Plan) Create(options ...PlanCreateParameters) (*Plan, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Plan)
+	if err := s.client.validate(Call{
+		Resource: "Plan",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Plan    *Plan  `json:"plan"`
@@ -264,9 +311,10 @@ func (s Plan) Create(options ...PlanCreateParameters) (*Plan, error) {
 
 	path := "/plans"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -274,23 +322,30 @@ func (s Plan) Create(options ...PlanCreateParameters) (*Plan, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Plan",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -308,6 +363,11 @@ type PlanFindParameters struct {
 
 // Find allows you to find a plan by its ID.
 func (s Plan) Find(planID string, options ...PlanFindParameters) (*Plan, error) {
+	return s.FindWithContext(context.Background(), planID, options...)
+}
+
+// FindWithContext allows you to find a plan by its ID.
+func (s Plan) FindWithContext(ctx context.Context, planID string, options ...PlanFindParameters) (*Plan, error) {
 	if s.client == nil {
 		panic("Please use the client.NewPlan() method to create a new Plan object")
 	}
@@ -345,9 +405,10 @@ func (s Plan) Find(planID string, options ...PlanFindParameters) (*Plan, error)
 
 	path := "/plans/" + url.QueryEscape(planID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -355,23 +416,31 @@ func (s Plan) Find(planID string, options ...PlanFindParameters) (*Plan, error)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Plan",
+		Method:     "Find",
+		ResourceID: planID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -389,6 +458,11 @@ type PlanSaveParameters struct {
 
 // Save allows you to save the updated plan attributes. This action won't affect subscriptions already linked to this plan.
 func (s Plan) Save(options ...PlanSaveParameters) (*Plan, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated plan attributes. This action won't affect subscriptions already linked to this plan.
+func (s Plan) SaveWithContext(ctx context.Context, options ...PlanSaveParameters) (*Plan, error) {
 	if s.client == nil {
 		panic("Please use the client.NewPlan() method to create a new Plan object")
 	}
@@ -404,6 +478,14 @@ func (s Plan) Save(options ...PlanSaveParameters) (*Plan, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Plan)
+	if err := s.client.validate(Call{
+		Resource:   "Plan",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Plan    *Plan  `json:"plan"`
@@ -436,9 +518,10 @@ func (s Plan) Save(options ...PlanSaveParameters) (*Plan, error) {
 
 	path := "/plans/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -446,23 +529,31 @@ func (s Plan) Save(options ...PlanSaveParameters) (*Plan, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Plan",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -480,6 +571,11 @@ type PlanEndParameters struct {
 
 // End allows you to delete a plan. Subscriptions linked to this plan won't be affected.
 func (s Plan) End(options ...PlanEndParameters) error {
+	return s.EndWithContext(context.Background(), options...)
+}
+
+// EndWithContext allows you to delete a plan. Subscriptions linked to this plan won't be affected.
+func (s Plan) EndWithContext(ctx context.Context, options ...PlanEndParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewPlan() method to create a new Plan object")
 	}
@@ -516,9 +612,10 @@ func (s Plan) End(options ...PlanEndParameters) error {
 
 	path := "/plans/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -526,23 +623,31 @@ func (s Plan) End(options ...PlanEndParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Plan",
+		Method:     "End",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -550,6 +655,17 @@ func (s Plan) End(options ...PlanEndParameters) error {
 	return nil
 }
 
+// AmountMoney returns the amount of the plan in its currency
+func (s *Plan) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, s.Currency)
+}
+
+// Validate returns a validation error if the currency of the plan isn't
+// an ISO 4217 currency. It implements Validator
+func (s *Plan) Validate() error {
+	return validateCurrency("currency", s.Currency)
+}
+
 // dummyPlan is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
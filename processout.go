@@ -2,7 +2,9 @@ package processout
 
 import (
 	"fmt"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -10,7 +12,8 @@ var (
 	// RequestAPIVersion is the default version of the API used in requests
 	// made with this package
 	RequestAPIVersion = "1.4.0.0"
-	// Host is the URL where API requests are made
+	// Host is the URL where API requests are made when the client does not
+	// specify its own BaseURL
 	Host = "https://api.processout.com"
 
 	// DefaultClient sets the HTTP default client used for ProcessOut clients
@@ -26,13 +29,40 @@ type ProcessOut struct {
 	APIVersion string
 	// UserAgent is the UserAgent that will be used to send the request
 	UserAgent string
+	// BaseURL is the URL where API requests made by this client are sent.
+	// When empty, the package-level Host is used
+	BaseURL string
 	// ProcessOut project ID
 	projectID string
 	// ProcessOut project secret key
 	projectSecret string
+	// credentials provides the credentials of the requests instead of
+	// projectID and projectSecret, when set
+	credentials CredentialsProvider
 
 	// HTTPClient used to make requests
 	HTTPClient *http.Client
+	// RetryPolicy is the policy used to retry failed requests. Requests
+	// are not retried when nil
+	RetryPolicy *RetryPolicy
+	// Middlewares is the chain of middlewares every request goes through
+	// before being sent by HTTPClient. See Use
+	Middlewares []Middleware
+	// Validators are the hooks validating the resources before they are
+	// sent by their Create and Save methods. See UseValidators
+	Validators []ValidateFunc
+	// EnableValidation enables the Validate method of the resources
+	// implementing Validator, such as the checks of their currency, which
+	// are disabled by default. The Validators are called in any case
+	EnableValidation bool
+	// Metrics records the metrics of the API calls, if set
+	Metrics MetricsRecorder
+	// Logger logs a summary of every attempt of the API calls, if set
+	Logger *slog.Logger
+	// LogOptions configures the logs of the API calls
+	LogOptions LogOptions
+	// Limiter throttles the requests of the client, if set
+	Limiter *Limiter
 }
 
 // Options represents the options available when doing a request to the
@@ -60,6 +90,16 @@ func New(projectID, projectSecret string) *ProcessOut {
 	return p
 }
 
+// baseURL returns the URL where the API requests of the client should be
+// sent, without any trailing slash
+func (c *ProcessOut) baseURL() string {
+	if c.BaseURL != "" {
+		return strings.TrimSuffix(c.BaseURL, "/")
+	}
+
+	return strings.TrimSuffix(Host, "/")
+}
+
 func setupRequest(client *ProcessOut, opt *Options, req *http.Request) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("API-Version", client.APIVersion)
@@ -2,8 +2,22 @@ package processout
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"gopkg.in/processout.v4/errors"
 )
 
 func getClient() *ProcessOut {
@@ -234,3 +248,810 @@ func TestCreateSupervisedProject(t *testing.T) {
 		t.Errorf("There shouldn't have been any error, but got %s", err.Error())
 	}
 }
+
+func TestFindInvoiceWithCanceledContext(t *testing.T) {
+	p := getClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.NewInvoice().FindWithContext(ctx, "iv_test")
+	if err == nil {
+		t.Fatalf("The request should have failed with a canceled context")
+	}
+	if _, ok := err.(*errors.NetworkError); !ok {
+		t.Errorf("The error should have been a network error, but got %T", err)
+	}
+	if !stderrors.Is(err, context.Canceled) {
+		t.Errorf("The error should have wrapped context.Canceled")
+	}
+}
+
+func TestClientBaseURL(t *testing.T) {
+	for _, id := range []string{"iv_first", "iv_second"} {
+		id := id
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/invoices/"+id {
+					t.Errorf("Unexpected path %s", r.URL.Path)
+				}
+				fmt.Fprintf(w, `{"success":true,"invoice":{"id":%q}}`, id)
+			}))
+			defer srv.Close()
+
+			p := New("project-id", "project-secret")
+			p.BaseURL = srv.URL + "/"
+
+			iv, err := p.NewInvoice().Find(id)
+			if err != nil {
+				t.Fatalf("The invoice could not be fetched: %s", err.Error())
+			}
+			if *iv.ID != id {
+				t.Errorf("The invoice ID should be %s but was %s", id, *iv.ID)
+			}
+		})
+	}
+}
+
+func TestRetryReusesIdempotencyKey(t *testing.T) {
+	keys := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"invoice":{"id":"iv_retried"}}`)
+	}))
+	defer srv.Close()
+
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+	p.RetryPolicy = &RetryPolicy{
+		MaxAttempts:       3,
+		MinBackoff:        time.Millisecond,
+		RetryableStatuses: []int{http.StatusServiceUnavailable},
+	}
+
+	iv, err := p.NewInvoice(&Invoice{
+		Name:     String("test invoice"),
+		Amount:   String("9.99"),
+		Currency: String("EUR"),
+	}).Create()
+	if err != nil {
+		t.Fatalf("The invoice should have been created after retrying, but got: %s", err.Error())
+	}
+	if *iv.ID != "iv_retried" {
+		t.Errorf("The invoice ID should be iv_retried but was %s", *iv.ID)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("There should have been 3 attempts, but got %d", len(keys))
+	}
+	if keys[0] == "" || keys[0] != keys[1] || keys[1] != keys[2] {
+		t.Errorf("The same idempotency key should have been sent on every attempt, but got %v", keys)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	p := New("project-id", "project-secret")
+	p.HTTPClient = &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("The request should not have been sent")
+		return nil, nil
+	})}
+
+	calls := []string{}
+	p.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(call *Call, req *http.Request) (*http.Response, error) {
+			calls = append(calls, call.Resource+"."+call.Method+" "+call.ResourceID)
+			return next(call, req)
+		}
+	}, func(next RoundTripFunc) RoundTripFunc {
+		return func(call *Call, req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"success":true,"transaction":{"id":"tr_stub"}}`)),
+				Request:    req,
+			}, nil
+		}
+	})
+
+	tr, err := p.NewInvoice(&Invoice{ID: String("iv_stub")}).Capture("tok_stub")
+	if err != nil {
+		t.Fatalf("The invoice should have been captured, but got: %s", err.Error())
+	}
+	if *tr.ID != "tr_stub" {
+		t.Errorf("The transaction ID should be tr_stub but was %s", *tr.ID)
+	}
+	if len(calls) != 1 || calls[0] != "Invoice.Capture iv_stub" {
+		t.Errorf("The middleware should have seen Invoice.Capture iv_stub, but got %v", calls)
+	}
+}
+
+func TestMiddlewareError(t *testing.T) {
+	p := New("project-id", "project-secret")
+	p.RetryPolicy = &RetryPolicy{MaxAttempts: 3}
+	attempts := 0
+	p.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(call *Call, req *http.Request) (*http.Response, error) {
+			attempts++
+			return nil, errors.New(nil, "request.blocked", "The call was blocked")
+		}
+	})
+
+	_, err := p.NewInvoice(&Invoice{ID: String("iv_stub")}).Capture("tok_stub")
+	if cerr, ok := err.(errors.CodedError); !ok || cerr.Code() != "request.blocked" {
+		t.Fatalf("The middleware error should have been returned as is, but got: %v", err)
+	}
+	var nerr *errors.NetworkError
+	if stderrors.As(err, &nerr) {
+		t.Errorf("The middleware error should not have been wrapped in a NetworkError")
+	}
+	if errors.IsRetryable(err) || attempts != 1 {
+		t.Errorf("The middleware error should not have been retried, but got %d attempts", attempts)
+	}
+}
+
+func TestMiddlewareNoResponse(t *testing.T) {
+	p := New("project-id", "project-secret")
+	p.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(call *Call, req *http.Request) (*http.Response, error) {
+			return nil, nil
+		}
+	})
+
+	_, err := p.NewInvoice().Find("iv_stub")
+	if _, ok := err.(*errors.NetworkError); !ok {
+		t.Errorf("A missing response should be returned as a network error, got %v", err)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestInternalErrorDetails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Request-Id", "req_test")
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, `{"success":false,"error_type":"processout.service-unavailable","message":"The gateway is unavailable"}`)
+	}))
+	defer srv.Close()
+
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+
+	_, err := p.NewInvoice().Find("iv_test")
+	ierr, ok := err.(*errors.InternalError)
+	if !ok {
+		t.Fatalf("The error should have been an internal error, but got %T", err)
+	}
+	if ierr.StatusCode() != http.StatusBadGateway {
+		t.Errorf("The status code should be 502 but was %d", ierr.StatusCode())
+	}
+	if ierr.Code() != "processout.service-unavailable" {
+		t.Errorf("The error code was not kept, got %s", ierr.Code())
+	}
+	if ierr.Error() != "The gateway is unavailable" {
+		t.Errorf("The error message was not kept, got %s", ierr.Error())
+	}
+	if ierr.RequestID() != "req_test" {
+		t.Errorf("The request ID should be req_test but was %s", ierr.RequestID())
+	}
+	if !strings.Contains(ierr.Body(), "The gateway is unavailable") {
+		t.Errorf("The response body was not kept, got %s", ierr.Body())
+	}
+}
+
+func TestNonJSONErrorDetails(t *testing.T) {
+	status := http.StatusBadRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("Request-Id", "req_test")
+		w.WriteHeader(status)
+		fmt.Fprint(w, "Too many requests, slow down")
+	}))
+	defer srv.Close()
+
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+
+	for _, status = range []int{http.StatusBadRequest, http.StatusTooManyRequests} {
+		_, err := p.NewInvoice().Find("iv_test")
+		verr, ok := err.(*errors.ValidationError)
+		if !ok {
+			t.Fatalf("%d: the error should have been a validation error, but got %T: %v", status, err, err)
+		}
+		if verr.StatusCode() != status || verr.RequestID() != "req_test" {
+			t.Errorf("%d: the status and request ID were not kept, got %d and %s", status, verr.StatusCode(), verr.RequestID())
+		}
+		if errors.IsRetryable(err) != (status == http.StatusTooManyRequests) {
+			t.Errorf("%d: only rate limited requests should be retryable", status)
+		}
+	}
+}
+
+func TestValidationErrorFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success":false,"error_type":"request.validation.error","message":"The request is invalid","fields":[{"field":"amount","error_type":"request.validation.invalid-amount","message":"The amount must be positive"}]}`)
+	}))
+	defer srv.Close()
+
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+
+	_, err := p.NewInvoice(&Invoice{Amount: String("-1")}).Create()
+	if !stderrors.Is(err, errors.ErrValidation) {
+		t.Fatalf("The error should have matched ErrValidation, but got %T", err)
+	}
+	if stderrors.Is(err, errors.ErrNotFound) {
+		t.Errorf("The error should not have matched ErrNotFound")
+	}
+	var verr *errors.ValidationError
+	if !stderrors.As(err, &verr) {
+		t.Fatalf("The error should have been a validation error")
+	}
+	if verr.StatusCode() != http.StatusBadRequest || verr.Retryable() {
+		t.Errorf("The error should be a non-retryable 400, got %d", verr.StatusCode())
+	}
+	fields := verr.Fields()
+	if len(fields) != 1 || fields[0].Field != "amount" || fields[0].Code != "request.validation.invalid-amount" {
+		t.Errorf("The amount field error was not parsed, got %+v", fields)
+	}
+}
+
+func TestTransactionErrorClass(t *testing.T) {
+	cases := map[string]errors.Class{
+		"card.insufficient-funds":   errors.ClassSoftDecline,
+		"card.expired":              errors.ClassHardDecline,
+		"card.stolen":               errors.ClassFraud,
+		"request.validation.amount": errors.ClassIntegration,
+		"something.else":            errors.ClassUnknown,
+	}
+	for code, class := range cases {
+		tr := &Transaction{ErrorCode: String(code)}
+		if c := tr.ErrorClass(); c != class {
+			t.Errorf("The code %s should be classified as %s but was %s", code, class, c)
+		}
+	}
+	if c := (&Transaction{}).ErrorClass(); c != errors.ClassUnknown {
+		t.Errorf("A transaction without error code should be unknown, but was %s", c)
+	}
+}
+
+func TestIteratorPagination(t *testing.T) {
+	ids := []string{"cust_1", "cust_2", "cust_3", "cust_4", "cust_5"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("start_after") != "" && q.Get("end_before") != "" {
+			t.Errorf("start_after and end_before should never be both set")
+		}
+		if q.Get("start_after") == "cust_boom" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success":false,"error_type":"resource.not-found","message":"Unknown cursor"}`)
+			return
+		}
+
+		from, to := 0, len(ids)
+		for n, id := range ids {
+			if id == q.Get("start_after") {
+				from = n + 1
+			}
+			if id == q.Get("end_before") {
+				to = n
+			}
+		}
+		if q.Get("end_before") != "" && to-from > 2 {
+			from = to - 2
+		} else if to-from > 2 {
+			to = from + 2
+		}
+		hasMore := to < len(ids)
+		if q.Get("end_before") != "" {
+			hasMore = from > 0
+		}
+
+		page := []string{}
+		for _, id := range ids[from:to] {
+			page = append(page, fmt.Sprintf(`{"id":%q}`, id))
+		}
+		fmt.Fprintf(w, `{"success":true,"has_more":%t,"customers":[%s]}`, hasMore, strings.Join(page, ","))
+	}))
+	defer srv.Close()
+
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+
+	opt := &Options{Limit: 2}
+	custs, err := p.NewCustomer().All(CustomerAllParameters{Options: opt})
+	if err != nil {
+		t.Fatalf("The customers could not be listed: %s", err.Error())
+	}
+
+	seen := []string{}
+	for custs.Next() {
+		seen = append(seen, *custs.Get().(*Customer).ID)
+	}
+	if err := custs.Error(); err != nil {
+		t.Fatalf("There shouldn't have been any error, but got %s", err.Error())
+	}
+	if strings.Join(seen, ",") != strings.Join(ids, ",") {
+		t.Errorf("All the customers should have been iterated on in order, but got %v", seen)
+	}
+	if opt.StartAfter != "" || opt.EndBefore != "" {
+		t.Errorf("The options given to All should not have been modified")
+	}
+
+	seen = []string{}
+	for custs.Prev() {
+		seen = append(seen, *custs.Get().(*Customer).ID)
+	}
+	if strings.Join(seen, ",") != "cust_5,cust_4,cust_3,cust_2,cust_1" {
+		t.Errorf("All the customers should have been iterated on backwards, but got %v", seen)
+	}
+
+	custs.ResumeFrom("cust_3")
+	if !custs.Next() || custs.Cursor() != "cust_4" {
+		t.Errorf("The iteration should have resumed after cust_3, but got cursor %s", custs.Cursor())
+	}
+
+	custs.ResumeFrom("")
+	if _, err := custs.NextPage(); err != nil || custs.Cursor() != "" {
+		t.Errorf("The cursor of the first page should be empty, got %s", custs.Cursor())
+	}
+	if _, err := custs.NextPage(); err != nil || custs.Cursor() != "cust_2" {
+		t.Errorf("The cursor should be the one the page was fetched after, got %s", custs.Cursor())
+	}
+	custs.ResumeFrom(custs.Cursor())
+	if !custs.Next() || custs.Cursor() != "cust_3" {
+		t.Errorf("The iteration should have resumed after cust_2, but got cursor %s", custs.Cursor())
+	}
+
+	custs, err = p.NewCustomer().All(CustomerAllParameters{Options: &Options{Limit: 2, EndBefore: "cust_4"}})
+	if err != nil {
+		t.Fatalf("The customers could not be listed: %s", err.Error())
+	}
+	seen = []string{}
+	for custs.Next() {
+		seen = append(seen, *custs.Get().(*Customer).ID)
+	}
+	if strings.Join(seen, ",") != "cust_2,cust_3,cust_4,cust_5" {
+		t.Errorf("The customers following the first page should have been iterated on, but got %v", seen)
+	}
+	custs, _ = p.NewCustomer().All(CustomerAllParameters{Options: &Options{Limit: 2, EndBefore: "cust_4"}})
+	if !custs.Next() || !custs.Prev() || *custs.Get().(*Customer).ID != "cust_1" {
+		t.Errorf("The customers preceding the first page should have been iterated on")
+	}
+
+	custs.ResumeFrom("cust_boom")
+	if custs.Next() {
+		t.Errorf("There shouldn't have been any iteration")
+	}
+	if !stderrors.Is(custs.Error(), errors.ErrNotFound) {
+		t.Errorf("The page error should have been decoded, but got %v", custs.Error())
+	}
+}
+
+func TestEventDecode(t *testing.T) {
+	p := New("project-id", "project-secret")
+
+	ev := p.NewEvent()
+	if err := json.Unmarshal([]byte(`{"id":"ev_test","name":"transaction.captured","data":{"transaction":{"id":"tr_test","status":"completed"}}}`), ev); err != nil {
+		t.Fatalf("The event could not be decoded: %s", err.Error())
+	}
+
+	data, err := ev.Decode()
+	if err != nil {
+		t.Fatalf("The event data could not be decoded: %s", err.Error())
+	}
+	tr, ok := data.(*Transaction)
+	if !ok || *tr.ID != "tr_test" {
+		t.Fatalf("The event data should have been the transaction tr_test, but got %#v", data)
+	}
+	if tr.client != p {
+		t.Errorf("The client should have been attached to the transaction")
+	}
+
+	tr, err = ev.Transaction()
+	if err != nil || *tr.Status != "completed" {
+		t.Errorf("The transaction could not be decoded: %v", err)
+	}
+	if _, err := ev.Subscription(); err == nil {
+		t.Errorf("There should be no subscription in the event data")
+	}
+}
+
+func TestWebhookEndpointCreate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/webhook-endpoints" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body := struct {
+			URL             string   `json:"url"`
+			EventsWhitelist []string `json:"events_whitelist"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("The body could not be decoded: %s", err.Error())
+		}
+		if len(body.EventsWhitelist) != 1 || body.EventsWhitelist[0] != "invoice.completed" {
+			t.Errorf("Unexpected events whitelist %v", body.EventsWhitelist)
+		}
+		fmt.Fprintf(w, `{"success":true,"webhook_endpoint":{"id":"whe_test","url":%q,"events_whitelist":["invoice.completed"]}}`, body.URL)
+	}))
+	defer srv.Close()
+
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+
+	events := []string{"invoice.completed"}
+	url := "https://example.com/webhooks"
+	we, err := p.NewWebhookEndpoint(&WebhookEndpoint{
+		URL:             &url,
+		EventsWhitelist: &events,
+	}).Create()
+	if err != nil {
+		t.Fatalf("The webhook endpoint could not be created: %s", err.Error())
+	}
+	if *we.ID != "whe_test" || *we.URL != url {
+		t.Errorf("Unexpected webhook endpoint %s %s", *we.ID, *we.URL)
+	}
+	if we.EventsWhitelist == nil || len(*we.EventsWhitelist) != 1 {
+		t.Errorf("The events whitelist should have been decoded")
+	}
+}
+
+func TestMoney(t *testing.T) {
+	tr := &Transaction{
+		Currency:       String("JPY"),
+		CapturedAmount: String("1000"),
+		RefundedAmount: String("250"),
+	}
+	captured, err := tr.CapturedAmountMoney()
+	if err != nil {
+		t.Fatalf("The captured amount could not be parsed: %s", err.Error())
+	}
+	refunded, _ := tr.RefundedAmountMoney()
+	available, err := captured.Sub(refunded)
+	if err != nil || available.String() != "750 JPY" {
+		t.Errorf("The available amount should be 750 JPY, got %s (%v)", available, err)
+	}
+	if _, err := available.Add(MoneyFromMinorUnits(100, "USD")); err == nil {
+		t.Errorf("Adding amounts in different currencies should fail")
+	}
+
+	kwd, _ := ParseMoney("1.2345", "kwd")
+	if kwd.Round().String() != "1.235 KWD" {
+		t.Errorf("The amount should be rounded to 3 decimals, got %s", kwd.Round())
+	}
+	if _, err := kwd.MinorUnits(); err == nil {
+		t.Errorf("The amount has too many decimals to be expressed in minor units")
+	}
+	if units, _ := MoneyFromMinorUnits(-1050, "EUR").MinorUnits(); units != -1050 {
+		t.Errorf("The minor units should be -1050, got %d", units)
+	}
+
+	if MustParseAmount("0.1").Add(MustParseAmount("0.2")).Cmp(MustParseAmount("0.30")) != 0 {
+		t.Errorf("0.1 + 0.2 should be exactly 0.3")
+	}
+	if s := MustParseAmount("-2.5").Round(0).String(); s != "-3" {
+		t.Errorf("-2.5 should be rounded to -3, got %s", s)
+	}
+
+	var v struct {
+		Amount Amount `json:"amount"`
+	}
+	if err := json.Unmarshal([]byte(`{"amount":"10.50"}`), &v); err != nil || v.Amount.String() != "10.50" {
+		t.Errorf("The amount should have been decoded to 10.50, got %s (%v)", v.Amount, err)
+	}
+	if b, _ := json.Marshal(v); string(b) != `{"amount":"10.50"}` {
+		t.Errorf("The amount should have been encoded as a string, got %s", b)
+	}
+	if _, err := ParseAmount("1e3"); err == nil {
+		t.Errorf("1e3 should not be a valid amount")
+	}
+}
+
+func TestCurrencyValidation(t *testing.T) {
+	p := New("project-id", "project-secret")
+	p.HTTPClient = &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("the request should not have been sent")
+	})}
+
+	invoice := &Invoice{
+		Name:     String("Test invoice"),
+		Amount:   String("10.00"),
+		Currency: String("EURO"),
+	}
+	_, err := p.NewInvoice(invoice).Create()
+	if _, ok := err.(*errors.NetworkError); !ok {
+		t.Errorf("The currency should not be validated by default, got %v", err)
+	}
+
+	p.EnableValidation = true
+	_, err = p.NewInvoice(invoice).Create()
+	verr, ok := err.(*errors.ValidationError)
+	if !ok || len(verr.Fields()) != 1 || verr.Fields()[0].Code != errors.CodeRequestInvalidCurrency {
+		t.Fatalf("The invalid currency should have been rejected, got %v", err)
+	}
+
+	withOptions := NewWithOptions(WithCredentials("project-id", "project-secret"),
+		WithHTTPClient(p.HTTPClient), WithValidation())
+	if _, err := withOptions.NewInvoice(invoice).Create(); !stderrors.Is(err, errors.ErrValidation) {
+		t.Errorf("The option should have enabled the validation, got %v", err)
+	}
+
+	var validated *Call
+	p.UseValidators(func(call *Call, resource interface{}) error {
+		validated = call
+		if plan := resource.(*Plan); ToString(plan.Interval) == "" {
+			return errors.New(nil, "", "The plan has no interval")
+		}
+		return nil
+	})
+	_, err = p.NewPlan(&Plan{Currency: String("usd")}).Create()
+	if err == nil || validated == nil || validated.Resource != "Plan" || validated.Method != "Create" {
+		t.Errorf("The plan should have been rejected by the validator, got %v", err)
+	}
+
+	if c, ok := LookupCurrency("kwd"); !ok || c.MinorUnits != 3 || c.Symbol != "KD" {
+		t.Errorf("KWD should have 3 minor units, got %+v", c)
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	cases := []struct {
+		amount, currency, locale, expected string
+	}{
+		{"1234.5", "USD", "en-US", "$1,234.50"},
+		{"-1234.5", "EUR", "fr_FR", "-1\u202f234,50\u00a0€"},
+		{"1234567.891", "EUR", "de-DE", "1.234.567,89 €"},
+		{"1234.5", "JPY", "ja", "¥1,235"},
+		{"0.5", "BOV", "xx", "BOV0.50"},
+	}
+	for _, c := range cases {
+		m, _ := ParseMoney(c.amount, c.currency)
+		if s := m.Format(c.locale); s != c.expected {
+			t.Errorf("%s %s should be formatted as %q in %s, got %q", c.amount, c.currency, c.expected, c.locale, s)
+		}
+	}
+}
+
+func TestTransactionLocalConversion(t *testing.T) {
+	tr := &Transaction{
+		Amount:      String("100.00"),
+		AmountLocal: String("91.50"),
+		Currency:    String("USD"),
+		Project:     &Project{DefaultCurrency: String("EUR")},
+	}
+
+	local, err := tr.ToLocal(NewMoney(MustParseAmount("10.00"), "USD"))
+	if err != nil || local.String() != "9.15 EUR" {
+		t.Errorf("10.00 USD should be converted to 9.15 EUR, got %s (%v)", local, err)
+	}
+	amount, err := tr.FromLocal(local)
+	if err != nil || amount.String() != "10.00 USD" {
+		t.Errorf("9.15 EUR should be converted back to 10.00 USD, got %s (%v)", amount, err)
+	}
+	if _, err := tr.ToLocal(NewMoney(MustParseAmount("10.00"), "GBP")); err == nil {
+		t.Errorf("Converting an amount in another currency should fail")
+	}
+}
+
+func TestLoggerRedaction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Request-Id", "req_test")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"success":false,"error_type":"request.validation.error","request":%s}`, body)
+	}))
+	defer srv.Close()
+
+	logs := &bytes.Buffer{}
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+	p.Logger = slog.New(slog.NewJSONHandler(logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	p.LogOptions.Details = true
+
+	_, err := p.NewCustomer(&Customer{
+		Email:         String("john@example.com"),
+		PhoneNumber:   String("+33 6 12 34 56 78"),
+		LegalDocument: String("123.456.789-10"),
+		Metadata: &map[string]string{
+			"card":    "4242 4242 4242 4242",
+			"gateway": "gway_req_eyJjYXJkIjoiNDI0MiJ9==",
+		},
+	}).Create()
+	if err == nil {
+		t.Fatalf("The customer creation should have failed")
+	}
+
+	out := logs.String()
+	for _, secret := range []string{"john@example.com", "12 34 56", "123.456.789", "4242 4242",
+		"eyJjYXJkIjoiNDI0MiJ9", "Basic "} {
+		if strings.Contains(out, secret) {
+			t.Errorf("The logs should not contain %q: %s", secret, out)
+		}
+	}
+	var entry map[string]interface{}
+	if err := json.Unmarshal(logs.Bytes(), &entry); err != nil {
+		t.Fatalf("The logs should contain a single JSON entry: %s", err.Error())
+	}
+	if entry["level"] != "WARN" || entry["resource"] != "Customer" || entry["error_code"] != "request.validation.error" ||
+		entry["request_id"] != "req_test" || entry["status"] != float64(400) {
+		t.Errorf("Unexpected log entry %v", entry)
+	}
+
+	// A middleware may return neither a response nor an error
+	logs.Reset()
+	metrics := &recordedMetrics{}
+	p.Metrics = metrics
+	req := httptest.NewRequest("GET", "/customers", nil)
+	p.recordMetrics(&Call{Resource: "Customer", Method: "All"}, time.Now(), nil, nil)
+	p.logCall(&Call{Resource: "Customer", Method: "All"}, req, time.Now(), nil, nil)
+	if len(metrics.calls) != 1 || !metrics.calls[0].Failed() || !strings.Contains(logs.String(), `"level":"WARN"`) {
+		t.Errorf("A missing response should be recorded as a failure, got %v and %s", metrics.calls, logs.String())
+	}
+}
+
+type recordedMetrics struct {
+	calls []CallMetrics
+}
+
+func (r *recordedMetrics) RecordCall(m CallMetrics) {
+	r.calls = append(r.calls, m)
+}
+
+func TestLimiter(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight, limited := 0, 0, true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		rateLimited := limited && strings.HasPrefix(r.URL.Path, "/transactions")
+		limited = limited && !rateLimited
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		if rateLimited {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"success":false,"error_type":"request.rate.exceeded"}`)
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, `{"success":true,"customer":{"id":"cust_test"},"transaction":{"id":"tr_test"}}`)
+	}))
+	defer srv.Close()
+
+	p := New("project-id", "project-secret")
+	p.BaseURL = srv.URL
+	p.Limiter = NewLimiter(RateLimit{MaxInFlight: 2}).
+		SetEndpoint("Customer.Find", RateLimit{Rate: 50, Burst: 1})
+	p.Limiter.RateLimitedDelay = 50 * time.Millisecond
+
+	start := time.Now()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := p.NewCustomer().Find("cust_test"); err != nil {
+				t.Errorf("The customer could not be fetched: %s", err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+	if maxInFlight > 2 {
+		t.Errorf("There should have been at most 2 requests in flight, got %d", maxInFlight)
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("The requests should have been throttled to 50 per second, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	p.NewCustomer().Find("cust_test")
+	if _, err := p.NewCustomer().FindWithContext(ctx, "cust_test"); !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("The throttled request should have been canceled with its context, got %v", err)
+	}
+
+	if _, err := p.NewTransaction().Find("tr_test"); err == nil {
+		t.Fatalf("The request should have been rate limited")
+	}
+	start = time.Now()
+	if _, err := p.NewTransaction().Find("tr_test"); err != nil {
+		t.Fatalf("The transaction could not be fetched: %s", err.Error())
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("The requests should have been paused after being rate limited, took %s", elapsed)
+	}
+
+	l := NewLimiter(RateLimit{MaxInFlight: 1})
+	release, _ := l.wait(context.Background(), &Call{})
+	res := &http.Response{Body: io.NopCloser(strings.NewReader(`{"success":true}`))}
+	releaseBody(res, release)
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := l.wait(ctx, &Call{}); err == nil {
+		t.Errorf("The request should stay in flight until its response body is read")
+	}
+	io.ReadAll(res.Body)
+	if _, err := l.wait(context.Background(), &Call{}); err != nil {
+		t.Errorf("The request should have been released once its response body was read, got %v", err)
+	}
+}
+
+func TestNewWithOptions(t *testing.T) {
+	type seen struct {
+		user, password, userAgent, apiVersion string
+	}
+	requests := []seen{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, _ := r.BasicAuth()
+		requests = append(requests, seen{user, password, r.Header.Get("User-Agent"), r.Header.Get("API-Version")})
+		fmt.Fprint(w, `{"success":true,"invoice":{"id":"iv_test"}}`)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	idFile, secretFile := filepath.Join(dir, "project-id"), filepath.Join(dir, "project-secret")
+	os.WriteFile(idFile, []byte("proj_test\n"), 0600)
+	os.WriteFile(secretFile, []byte("key_old\n"), 0600)
+	creds, err := NewFileCredentials(idFile, secretFile)
+	if err != nil {
+		t.Fatalf("The credentials could not be read: %s", err.Error())
+	}
+	creds.Interval = time.Nanosecond
+
+	p := NewWithOptions(
+		WithBaseURL(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithAPIVersion("1.3.0.0"),
+		WithUserAgent("test-agent"),
+		WithCredentialsProvider(creds),
+	)
+	if _, err := p.NewInvoice().Find("iv_test"); err != nil {
+		t.Fatalf("The invoice could not be fetched: %s", err.Error())
+	}
+	os.WriteFile(secretFile, []byte("key_rotated\n"), 0600)
+	if _, err := p.NewInvoice().Find("iv_test"); err != nil {
+		t.Fatalf("The invoice could not be fetched: %s", err.Error())
+	}
+	expected := []seen{
+		{"proj_test", "key_old", "test-agent", "1.3.0.0"},
+		{"proj_test", "key_rotated", "test-agent", "1.3.0.0"},
+	}
+	if len(requests) != 2 || requests[0] != expected[0] || requests[1] != expected[1] {
+		t.Errorf("The rotated credentials should have been used, got %v", requests)
+	}
+
+	t.Setenv(DefaultProjectIDEnv, "proj_env")
+	t.Setenv(DefaultProjectSecretEnv, "key_env")
+	p = NewWithOptions(WithBaseURL(srv.URL))
+	if _, err := p.NewInvoice().Find("iv_test"); err != nil {
+		t.Fatalf("The invoice could not be fetched: %s", err.Error())
+	}
+	if last := requests[len(requests)-1]; last.user != "proj_env" || last.password != "key_env" {
+		t.Errorf("The credentials should have been read from the environment, got %v", last)
+	}
+
+	t.Setenv(DefaultProjectSecretEnv, "")
+	p.RetryPolicy = DefaultRetryPolicy
+	_, err = p.NewInvoice().Find("iv_test")
+	if err == nil || len(requests) != 3 {
+		t.Errorf("The request should not have been sent without credentials, got %v", err)
+	}
+	if _, ok := err.(*errors.Error); !ok || errors.IsRetryable(err) {
+		t.Errorf("The missing credentials should be a non retryable error, got %T %v", err, err)
+	}
+}
@@ -0,0 +1,22 @@
+// Package processoutexpvar publishes the metrics of the ProcessOut API calls with the
+// expvar package of the standard library, for the services not using
+// Prometheus. It doesn't have any dependency:
+//
+//	rec := processoutexpvar.NewRecorder()
+//	expvar.Publish("processout", rec)
+//	client.Metrics = rec
+//
+// The metrics are then served as JSON by the /debug/vars endpoint:
+//
+//	{
+//		"requests": {"Invoice.Capture/200": 12, "Invoice.Capture/402/card.declined": 1},
+//		"errors": {"Invoice.Capture/402/card.declined": 1},
+//		"duration_seconds": {
+//			"Invoice.Capture/200": {"count": 12, "sum": 3.2, "buckets": {"0.05": 0, "0.1": 2, ...}}
+//		}
+//	}
+//
+// The requests and errors are keyed by resource and operation, HTTP status
+// and error code, and the durations by resource and operation and HTTP
+// status. The status is 0 when no response was received.
+package processoutexpvar
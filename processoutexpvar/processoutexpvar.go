@@ -0,0 +1,147 @@
+package processoutexpvar
+
+import (
+	"expvar"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/processout.v4"
+)
+
+// DefaultBuckets are the upper bounds of the buckets of the duration
+// histograms, in seconds
+var DefaultBuckets = []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// Recorder is a processout.MetricsRecorder keeping the metrics of the API
+// calls in expvar maps. It implements expvar.Var so that it can be
+// published
+type Recorder struct {
+	// Buckets are the upper bounds of the buckets of the duration
+	// histograms, in seconds, sorted. DefaultBuckets is used when nil. It
+	// must not be changed once calls were recorded
+	Buckets []float64
+
+	mu       sync.Mutex
+	vars     expvar.Map
+	requests expvar.Map
+	errors   expvar.Map
+	duration expvar.Map
+}
+
+var _ processout.MetricsRecorder = (*Recorder)(nil)
+var _ expvar.Var = (*Recorder)(nil)
+
+// NewRecorder creates a new Recorder. It isn't published, so that several
+// clients can use their own
+func NewRecorder() *Recorder {
+	r := &Recorder{}
+	r.vars.Set("requests", &r.requests)
+	r.vars.Set("errors", &r.errors)
+	r.vars.Set("duration_seconds", &r.duration)
+	return r
+}
+
+// RecordCall implements processout.MetricsRecorder
+func (r *Recorder) RecordCall(m processout.CallMetrics) {
+	key := m.Resource + "." + m.Method + "/" + strconv.Itoa(m.StatusCode)
+	full := key
+	if m.ErrorCode != "" {
+		full += "/" + m.ErrorCode
+	}
+
+	r.requests.Add(full, 1)
+	if m.Failed() {
+		r.errors.Add(full, 1)
+	}
+	r.histogram(key).observe(m.Duration.Seconds())
+}
+
+// histogram returns the duration histogram with the given key, creating it
+// if needed
+func (r *Recorder) histogram(key string) *histogram {
+	if h, ok := r.duration.Get(key).(*histogram); ok {
+		return h
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if h, ok := r.duration.Get(key).(*histogram); ok {
+		return h
+	}
+	buckets := r.Buckets
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	h := &histogram{
+		bounds: buckets,
+		counts: make([]uint64, len(buckets)),
+	}
+	r.duration.Set(key, h)
+	return h
+}
+
+// Requests returns the number of requests recorded for the given resource
+// and operation, such as Invoice and Capture, over all their statuses and
+// error codes
+func (r *Recorder) Requests(resource, operation string) int64 {
+	return sum(&r.requests, resource+"."+operation+"/")
+}
+
+// Errors returns the number of failed requests recorded for the given
+// resource and operation
+func (r *Recorder) Errors(resource, operation string) int64 {
+	return sum(&r.errors, resource+"."+operation+"/")
+}
+
+func sum(m *expvar.Map, prefix string) int64 {
+	var n int64
+	m.Do(func(kv expvar.KeyValue) {
+		if strings.HasPrefix(kv.Key, prefix) {
+			n += kv.Value.(*expvar.Int).Value()
+		}
+	})
+
+	return n
+}
+
+// String implements expvar.Var, returning the metrics as JSON
+func (r *Recorder) String() string {
+	return r.vars.String()
+}
+
+// histogram is a cumulative histogram of durations
+type histogram struct {
+	mu     sync.Mutex
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func (h *histogram) observe(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.count++
+	h.sum += v
+	for i, b := range h.bounds {
+		if v <= b {
+			h.counts[i]++
+		}
+	}
+}
+
+// String implements expvar.Var
+func (h *histogram) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	buckets := make([]string, 0, len(h.bounds))
+	for i, b := range h.bounds {
+		buckets = append(buckets, fmt.Sprintf("%q: %d", strconv.FormatFloat(b, 'g', -1, 64), h.counts[i]))
+	}
+	return fmt.Sprintf(`{"count": %d, "sum": %s, "buckets": {%s}}`,
+		h.count, strconv.FormatFloat(h.sum, 'g', -1, 64), strings.Join(buckets, ", "))
+}
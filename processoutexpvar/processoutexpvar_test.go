@@ -0,0 +1,57 @@
+package processoutexpvar
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/processout.v4"
+	"gopkg.in/processout.v4/processouttest"
+)
+
+func TestRecorder(t *testing.T) {
+	srv := processouttest.NewServer()
+	defer srv.Close()
+
+	rec := NewRecorder()
+	c := srv.Client()
+	c.Metrics = rec
+
+	iv, err := c.NewInvoice(&processout.Invoice{
+		Name:     processout.String("Test invoice"),
+		Amount:   processout.String("10.00"),
+		Currency: processout.String("USD"),
+	}).Create()
+	if err != nil {
+		t.Fatalf("The invoice could not be created: %s", err.Error())
+	}
+	if _, err := iv.Capture(processouttest.SourceDeclined); err == nil {
+		t.Fatalf("The capture should have been declined")
+	}
+	if _, err := iv.Capture(processouttest.SourceApproved); err != nil {
+		t.Fatalf("The invoice could not be captured: %s", err.Error())
+	}
+
+	if n := rec.Requests("Invoice", "Capture"); n != 2 {
+		t.Errorf("2 captures should have been recorded, got %d", n)
+	}
+	if n := rec.Errors("Invoice", "Capture"); n != 1 {
+		t.Errorf("1 failed capture should have been recorded, got %d", n)
+	}
+
+	var vars struct {
+		Requests map[string]int64 `json:"requests"`
+		Duration map[string]struct {
+			Count   uint64            `json:"count"`
+			Buckets map[string]uint64 `json:"buckets"`
+		} `json:"duration_seconds"`
+	}
+	if err := json.Unmarshal([]byte(rec.String()), &vars); err != nil {
+		t.Fatalf("The metrics should be valid JSON: %s", err.Error())
+	}
+	if vars.Requests["Invoice.Capture/402/card.declined"] != 1 || vars.Requests["Invoice.Create/200"] != 1 {
+		t.Errorf("Unexpected requests %v", vars.Requests)
+	}
+	if d := vars.Duration["Invoice.Capture/200"]; d.Count != 1 || d.Buckets["60"] != 1 {
+		t.Errorf("The duration of the capture should have been recorded, got %+v", d)
+	}
+}
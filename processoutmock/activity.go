@@ -0,0 +1,91 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// ActivityService is a mock of processout.ActivityService. Each method calls
+// the function of the same name. The methods without a context fall back to
+// the function of their WithContext variant, and the other way around, so
+// that only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type ActivityService struct {
+	AllFunc                 func(options ...processout.ActivityAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc      func(ctx context.Context, options ...processout.ActivityAllParameters) (*processout.Iterator, error)
+	AllTypedFunc            func(options ...processout.ActivityAllParameters) (*processout.TypedIterator[*processout.Activity], error)
+	AllTypedWithContextFunc func(ctx context.Context, options ...processout.ActivityAllParameters) (*processout.TypedIterator[*processout.Activity], error)
+	FindFunc                func(activityID string, options ...processout.ActivityFindParameters) (*processout.Activity, error)
+	FindWithContextFunc     func(ctx context.Context, activityID string, options ...processout.ActivityFindParameters) (*processout.Activity, error)
+}
+
+var _ processout.ActivityService = &ActivityService{}
+
+// All calls AllFunc
+func (m *ActivityService) All(options ...processout.ActivityAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *ActivityService) AllWithContext(ctx context.Context, options ...processout.ActivityAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: ActivityService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *ActivityService) AllTyped(options ...processout.ActivityAllParameters) (*processout.TypedIterator[*processout.Activity], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *ActivityService) AllTypedWithContext(ctx context.Context, options ...processout.ActivityAllParameters) (*processout.TypedIterator[*processout.Activity], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Activity]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *ActivityService) Find(activityID string, options ...processout.ActivityFindParameters) (*processout.Activity, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(activityID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), activityID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *ActivityService) FindWithContext(ctx context.Context, activityID string, options ...processout.ActivityFindParameters) (*processout.Activity, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, activityID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(activityID, options...)
+	}
+
+	panic("processoutmock: ActivityService.Find is not mocked")
+}
@@ -0,0 +1,160 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// AddonService is a mock of processout.AddonService. Each method calls the
+// function of the same name. The methods without a context fall back to the
+// function of their WithContext variant, and the other way around, so that
+// only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type AddonService struct {
+	FetchSubscriptionAddonsFunc                 func(subscriptionID string, options ...processout.AddonFetchSubscriptionAddonsParameters) (*processout.Iterator, error)
+	FetchSubscriptionAddonsWithContextFunc      func(ctx context.Context, subscriptionID string, options ...processout.AddonFetchSubscriptionAddonsParameters) (*processout.Iterator, error)
+	FetchSubscriptionAddonsTypedFunc            func(subscriptionID string, options ...processout.AddonFetchSubscriptionAddonsParameters) (*processout.TypedIterator[*processout.Addon], error)
+	FetchSubscriptionAddonsTypedWithContextFunc func(ctx context.Context, subscriptionID string, options ...processout.AddonFetchSubscriptionAddonsParameters) (*processout.TypedIterator[*processout.Addon], error)
+	CreateFunc                                  func(options ...processout.AddonCreateParameters) (*processout.Addon, error)
+	CreateWithContextFunc                       func(ctx context.Context, options ...processout.AddonCreateParameters) (*processout.Addon, error)
+	FindFunc                                    func(subscriptionID, addonID string, options ...processout.AddonFindParameters) (*processout.Addon, error)
+	FindWithContextFunc                         func(ctx context.Context, subscriptionID, addonID string, options ...processout.AddonFindParameters) (*processout.Addon, error)
+	SaveFunc                                    func(options ...processout.AddonSaveParameters) (*processout.Addon, error)
+	SaveWithContextFunc                         func(ctx context.Context, options ...processout.AddonSaveParameters) (*processout.Addon, error)
+	DeleteFunc                                  func(options ...processout.AddonDeleteParameters) error
+	DeleteWithContextFunc                       func(ctx context.Context, options ...processout.AddonDeleteParameters) error
+}
+
+var _ processout.AddonService = &AddonService{}
+
+// FetchSubscriptionAddons calls FetchSubscriptionAddonsFunc
+func (m *AddonService) FetchSubscriptionAddons(subscriptionID string, options ...processout.AddonFetchSubscriptionAddonsParameters) (*processout.Iterator, error) {
+	if m.FetchSubscriptionAddonsFunc != nil {
+		return m.FetchSubscriptionAddonsFunc(subscriptionID, options...)
+	}
+
+	return m.FetchSubscriptionAddonsWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FetchSubscriptionAddonsWithContext calls FetchSubscriptionAddonsWithContextFunc
+func (m *AddonService) FetchSubscriptionAddonsWithContext(ctx context.Context, subscriptionID string, options ...processout.AddonFetchSubscriptionAddonsParameters) (*processout.Iterator, error) {
+	if m.FetchSubscriptionAddonsWithContextFunc != nil {
+		return m.FetchSubscriptionAddonsWithContextFunc(ctx, subscriptionID, options...)
+	}
+	if m.FetchSubscriptionAddonsFunc != nil {
+		return m.FetchSubscriptionAddonsFunc(subscriptionID, options...)
+	}
+
+	panic("processoutmock: AddonService.FetchSubscriptionAddons is not mocked")
+}
+
+// FetchSubscriptionAddonsTyped calls FetchSubscriptionAddonsTypedFunc
+func (m *AddonService) FetchSubscriptionAddonsTyped(subscriptionID string, options ...processout.AddonFetchSubscriptionAddonsParameters) (*processout.TypedIterator[*processout.Addon], error) {
+	if m.FetchSubscriptionAddonsTypedFunc != nil {
+		return m.FetchSubscriptionAddonsTypedFunc(subscriptionID, options...)
+	}
+
+	return m.FetchSubscriptionAddonsTypedWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FetchSubscriptionAddonsTypedWithContext calls FetchSubscriptionAddonsTypedWithContextFunc
+func (m *AddonService) FetchSubscriptionAddonsTypedWithContext(ctx context.Context, subscriptionID string, options ...processout.AddonFetchSubscriptionAddonsParameters) (*processout.TypedIterator[*processout.Addon], error) {
+	if m.FetchSubscriptionAddonsTypedWithContextFunc != nil {
+		return m.FetchSubscriptionAddonsTypedWithContextFunc(ctx, subscriptionID, options...)
+	}
+	if m.FetchSubscriptionAddonsTypedFunc != nil {
+		return m.FetchSubscriptionAddonsTypedFunc(subscriptionID, options...)
+	}
+
+	it, err := m.FetchSubscriptionAddonsWithContext(ctx, subscriptionID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Addon]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *AddonService) Create(options ...processout.AddonCreateParameters) (*processout.Addon, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *AddonService) CreateWithContext(ctx context.Context, options ...processout.AddonCreateParameters) (*processout.Addon, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: AddonService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *AddonService) Find(subscriptionID, addonID string, options ...processout.AddonFindParameters) (*processout.Addon, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(subscriptionID, addonID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), subscriptionID, addonID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *AddonService) FindWithContext(ctx context.Context, subscriptionID, addonID string, options ...processout.AddonFindParameters) (*processout.Addon, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, subscriptionID, addonID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(subscriptionID, addonID, options...)
+	}
+
+	panic("processoutmock: AddonService.Find is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *AddonService) Save(options ...processout.AddonSaveParameters) (*processout.Addon, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *AddonService) SaveWithContext(ctx context.Context, options ...processout.AddonSaveParameters) (*processout.Addon, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: AddonService.Save is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *AddonService) Delete(options ...processout.AddonDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *AddonService) DeleteWithContext(ctx context.Context, options ...processout.AddonDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: AddonService.Delete is not mocked")
+}
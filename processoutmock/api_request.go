@@ -0,0 +1,91 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// APIRequestService is a mock of processout.APIRequestService. Each method
+// calls the function of the same name. The methods without a context fall
+// back to the function of their WithContext variant, and the other way
+// around, so that only one of them needs to be set. The Typed variants fall
+// back to the functions of the untyped ones. Calling a method whose function
+// isn't set panics
+type APIRequestService struct {
+	AllFunc                 func(options ...processout.APIRequestAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc      func(ctx context.Context, options ...processout.APIRequestAllParameters) (*processout.Iterator, error)
+	AllTypedFunc            func(options ...processout.APIRequestAllParameters) (*processout.TypedIterator[*processout.APIRequest], error)
+	AllTypedWithContextFunc func(ctx context.Context, options ...processout.APIRequestAllParameters) (*processout.TypedIterator[*processout.APIRequest], error)
+	FindFunc                func(APIRequestID string, options ...processout.APIRequestFindParameters) (*processout.APIRequest, error)
+	FindWithContextFunc     func(ctx context.Context, APIRequestID string, options ...processout.APIRequestFindParameters) (*processout.APIRequest, error)
+}
+
+var _ processout.APIRequestService = &APIRequestService{}
+
+// All calls AllFunc
+func (m *APIRequestService) All(options ...processout.APIRequestAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *APIRequestService) AllWithContext(ctx context.Context, options ...processout.APIRequestAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: APIRequestService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *APIRequestService) AllTyped(options ...processout.APIRequestAllParameters) (*processout.TypedIterator[*processout.APIRequest], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *APIRequestService) AllTypedWithContext(ctx context.Context, options ...processout.APIRequestAllParameters) (*processout.TypedIterator[*processout.APIRequest], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.APIRequest]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *APIRequestService) Find(APIRequestID string, options ...processout.APIRequestFindParameters) (*processout.APIRequest, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(APIRequestID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), APIRequestID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *APIRequestService) FindWithContext(ctx context.Context, APIRequestID string, options ...processout.APIRequestFindParameters) (*processout.APIRequest, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, APIRequestID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(APIRequestID, options...)
+	}
+
+	panic("processoutmock: APIRequestService.Find is not mocked")
+}
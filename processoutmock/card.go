@@ -0,0 +1,114 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// CardService is a mock of processout.CardService. Each method calls the
+// function of the same name. The methods without a context fall back to the
+// function of their WithContext variant, and the other way around, so that
+// only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type CardService struct {
+	AllFunc                  func(options ...processout.CardAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc       func(ctx context.Context, options ...processout.CardAllParameters) (*processout.Iterator, error)
+	AllTypedFunc             func(options ...processout.CardAllParameters) (*processout.TypedIterator[*processout.Card], error)
+	AllTypedWithContextFunc  func(ctx context.Context, options ...processout.CardAllParameters) (*processout.TypedIterator[*processout.Card], error)
+	FindFunc                 func(cardID string, options ...processout.CardFindParameters) (*processout.Card, error)
+	FindWithContextFunc      func(ctx context.Context, cardID string, options ...processout.CardFindParameters) (*processout.Card, error)
+	AnonymizeFunc            func(options ...processout.CardAnonymizeParameters) error
+	AnonymizeWithContextFunc func(ctx context.Context, options ...processout.CardAnonymizeParameters) error
+}
+
+var _ processout.CardService = &CardService{}
+
+// All calls AllFunc
+func (m *CardService) All(options ...processout.CardAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *CardService) AllWithContext(ctx context.Context, options ...processout.CardAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: CardService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *CardService) AllTyped(options ...processout.CardAllParameters) (*processout.TypedIterator[*processout.Card], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *CardService) AllTypedWithContext(ctx context.Context, options ...processout.CardAllParameters) (*processout.TypedIterator[*processout.Card], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Card]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *CardService) Find(cardID string, options ...processout.CardFindParameters) (*processout.Card, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(cardID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), cardID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *CardService) FindWithContext(ctx context.Context, cardID string, options ...processout.CardFindParameters) (*processout.Card, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, cardID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(cardID, options...)
+	}
+
+	panic("processoutmock: CardService.Find is not mocked")
+}
+
+// Anonymize calls AnonymizeFunc
+func (m *CardService) Anonymize(options ...processout.CardAnonymizeParameters) error {
+	if m.AnonymizeFunc != nil {
+		return m.AnonymizeFunc(options...)
+	}
+
+	return m.AnonymizeWithContext(context.Background(), options...)
+}
+
+// AnonymizeWithContext calls AnonymizeWithContextFunc
+func (m *CardService) AnonymizeWithContext(ctx context.Context, options ...processout.CardAnonymizeParameters) error {
+	if m.AnonymizeWithContextFunc != nil {
+		return m.AnonymizeWithContextFunc(ctx, options...)
+	}
+	if m.AnonymizeFunc != nil {
+		return m.AnonymizeFunc(options...)
+	}
+
+	panic("processoutmock: CardService.Anonymize is not mocked")
+}
@@ -0,0 +1,41 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// CardInformationService is a mock of processout.CardInformationService.
+// Each method calls the function of the same name. The methods without a
+// context fall back to the function of their WithContext variant, and the
+// other way around, so that only one of them needs to be set. The Typed
+// variants fall back to the functions of the untyped ones. Calling a method
+// whose function isn't set panics
+type CardInformationService struct {
+	FetchFunc            func(iin string, options ...processout.CardInformationFetchParameters) (*processout.CardInformation, error)
+	FetchWithContextFunc func(ctx context.Context, iin string, options ...processout.CardInformationFetchParameters) (*processout.CardInformation, error)
+}
+
+var _ processout.CardInformationService = &CardInformationService{}
+
+// Fetch calls FetchFunc
+func (m *CardInformationService) Fetch(iin string, options ...processout.CardInformationFetchParameters) (*processout.CardInformation, error) {
+	if m.FetchFunc != nil {
+		return m.FetchFunc(iin, options...)
+	}
+
+	return m.FetchWithContext(context.Background(), iin, options...)
+}
+
+// FetchWithContext calls FetchWithContextFunc
+func (m *CardInformationService) FetchWithContext(ctx context.Context, iin string, options ...processout.CardInformationFetchParameters) (*processout.CardInformation, error) {
+	if m.FetchWithContextFunc != nil {
+		return m.FetchWithContextFunc(ctx, iin, options...)
+	}
+	if m.FetchFunc != nil {
+		return m.FetchFunc(iin, options...)
+	}
+
+	panic("processoutmock: CardInformationService.Fetch is not mocked")
+}
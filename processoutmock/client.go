@@ -0,0 +1,210 @@
+package processoutmock
+
+import (
+	"gopkg.in/processout.v4"
+)
+
+// Client is a mock of processout.Client. Each method calls the function of
+// the same name, and panics if it isn't set
+type Client struct {
+	ActivitiesFunc            func(prefill ...*processout.Activity) processout.ActivityService
+	AddonsFunc                func(prefill ...*processout.Addon) processout.AddonService
+	APIRequestsFunc           func(prefill ...*processout.APIRequest) processout.APIRequestService
+	CardsFunc                 func(prefill ...*processout.Card) processout.CardService
+	CardInformationFunc       func(prefill ...*processout.CardInformation) processout.CardInformationService
+	CouponsFunc               func(prefill ...*processout.Coupon) processout.CouponService
+	CustomersFunc             func(prefill ...*processout.Customer) processout.CustomerService
+	TokensFunc                func(prefill ...*processout.Token) processout.TokenService
+	DiscountsFunc             func(prefill ...*processout.Discount) processout.DiscountService
+	EventsFunc                func(prefill ...*processout.Event) processout.EventService
+	GatewaysFunc              func(prefill ...*processout.Gateway) processout.GatewayService
+	GatewayConfigurationsFunc func(prefill ...*processout.GatewayConfiguration) processout.GatewayConfigurationService
+	InvoicesFunc              func(prefill ...*processout.Invoice) processout.InvoiceService
+	PayoutsFunc               func(prefill ...*processout.Payout) processout.PayoutService
+	PlansFunc                 func(prefill ...*processout.Plan) processout.PlanService
+	ProductsFunc              func(prefill ...*processout.Product) processout.ProductService
+	ProjectsFunc              func(prefill ...*processout.Project) processout.ProjectService
+	RefundsFunc               func(prefill ...*processout.Refund) processout.RefundService
+	SubscriptionsFunc         func(prefill ...*processout.Subscription) processout.SubscriptionService
+	TransactionsFunc          func(prefill ...*processout.Transaction) processout.TransactionService
+	WebhooksFunc              func(prefill ...*processout.Webhook) processout.WebhookService
+	WebhookEndpointsFunc      func(prefill ...*processout.WebhookEndpoint) processout.WebhookEndpointService
+}
+
+var _ processout.Client = &Client{}
+
+// Activities calls ActivitiesFunc
+func (m *Client) Activities(prefill ...*processout.Activity) processout.ActivityService {
+	if m.ActivitiesFunc == nil {
+		panic("processoutmock: Client.Activities is not mocked")
+	}
+	return m.ActivitiesFunc(prefill...)
+}
+
+// Addons calls AddonsFunc
+func (m *Client) Addons(prefill ...*processout.Addon) processout.AddonService {
+	if m.AddonsFunc == nil {
+		panic("processoutmock: Client.Addons is not mocked")
+	}
+	return m.AddonsFunc(prefill...)
+}
+
+// APIRequests calls APIRequestsFunc
+func (m *Client) APIRequests(prefill ...*processout.APIRequest) processout.APIRequestService {
+	if m.APIRequestsFunc == nil {
+		panic("processoutmock: Client.APIRequests is not mocked")
+	}
+	return m.APIRequestsFunc(prefill...)
+}
+
+// Cards calls CardsFunc
+func (m *Client) Cards(prefill ...*processout.Card) processout.CardService {
+	if m.CardsFunc == nil {
+		panic("processoutmock: Client.Cards is not mocked")
+	}
+	return m.CardsFunc(prefill...)
+}
+
+// CardInformation calls CardInformationFunc
+func (m *Client) CardInformation(prefill ...*processout.CardInformation) processout.CardInformationService {
+	if m.CardInformationFunc == nil {
+		panic("processoutmock: Client.CardInformation is not mocked")
+	}
+	return m.CardInformationFunc(prefill...)
+}
+
+// Coupons calls CouponsFunc
+func (m *Client) Coupons(prefill ...*processout.Coupon) processout.CouponService {
+	if m.CouponsFunc == nil {
+		panic("processoutmock: Client.Coupons is not mocked")
+	}
+	return m.CouponsFunc(prefill...)
+}
+
+// Customers calls CustomersFunc
+func (m *Client) Customers(prefill ...*processout.Customer) processout.CustomerService {
+	if m.CustomersFunc == nil {
+		panic("processoutmock: Client.Customers is not mocked")
+	}
+	return m.CustomersFunc(prefill...)
+}
+
+// Tokens calls TokensFunc
+func (m *Client) Tokens(prefill ...*processout.Token) processout.TokenService {
+	if m.TokensFunc == nil {
+		panic("processoutmock: Client.Tokens is not mocked")
+	}
+	return m.TokensFunc(prefill...)
+}
+
+// Discounts calls DiscountsFunc
+func (m *Client) Discounts(prefill ...*processout.Discount) processout.DiscountService {
+	if m.DiscountsFunc == nil {
+		panic("processoutmock: Client.Discounts is not mocked")
+	}
+	return m.DiscountsFunc(prefill...)
+}
+
+// Events calls EventsFunc
+func (m *Client) Events(prefill ...*processout.Event) processout.EventService {
+	if m.EventsFunc == nil {
+		panic("processoutmock: Client.Events is not mocked")
+	}
+	return m.EventsFunc(prefill...)
+}
+
+// Gateways calls GatewaysFunc
+func (m *Client) Gateways(prefill ...*processout.Gateway) processout.GatewayService {
+	if m.GatewaysFunc == nil {
+		panic("processoutmock: Client.Gateways is not mocked")
+	}
+	return m.GatewaysFunc(prefill...)
+}
+
+// GatewayConfigurations calls GatewayConfigurationsFunc
+func (m *Client) GatewayConfigurations(prefill ...*processout.GatewayConfiguration) processout.GatewayConfigurationService {
+	if m.GatewayConfigurationsFunc == nil {
+		panic("processoutmock: Client.GatewayConfigurations is not mocked")
+	}
+	return m.GatewayConfigurationsFunc(prefill...)
+}
+
+// Invoices calls InvoicesFunc
+func (m *Client) Invoices(prefill ...*processout.Invoice) processout.InvoiceService {
+	if m.InvoicesFunc == nil {
+		panic("processoutmock: Client.Invoices is not mocked")
+	}
+	return m.InvoicesFunc(prefill...)
+}
+
+// Payouts calls PayoutsFunc
+func (m *Client) Payouts(prefill ...*processout.Payout) processout.PayoutService {
+	if m.PayoutsFunc == nil {
+		panic("processoutmock: Client.Payouts is not mocked")
+	}
+	return m.PayoutsFunc(prefill...)
+}
+
+// Plans calls PlansFunc
+func (m *Client) Plans(prefill ...*processout.Plan) processout.PlanService {
+	if m.PlansFunc == nil {
+		panic("processoutmock: Client.Plans is not mocked")
+	}
+	return m.PlansFunc(prefill...)
+}
+
+// Products calls ProductsFunc
+func (m *Client) Products(prefill ...*processout.Product) processout.ProductService {
+	if m.ProductsFunc == nil {
+		panic("processoutmock: Client.Products is not mocked")
+	}
+	return m.ProductsFunc(prefill...)
+}
+
+// Projects calls ProjectsFunc
+func (m *Client) Projects(prefill ...*processout.Project) processout.ProjectService {
+	if m.ProjectsFunc == nil {
+		panic("processoutmock: Client.Projects is not mocked")
+	}
+	return m.ProjectsFunc(prefill...)
+}
+
+// Refunds calls RefundsFunc
+func (m *Client) Refunds(prefill ...*processout.Refund) processout.RefundService {
+	if m.RefundsFunc == nil {
+		panic("processoutmock: Client.Refunds is not mocked")
+	}
+	return m.RefundsFunc(prefill...)
+}
+
+// Subscriptions calls SubscriptionsFunc
+func (m *Client) Subscriptions(prefill ...*processout.Subscription) processout.SubscriptionService {
+	if m.SubscriptionsFunc == nil {
+		panic("processoutmock: Client.Subscriptions is not mocked")
+	}
+	return m.SubscriptionsFunc(prefill...)
+}
+
+// Transactions calls TransactionsFunc
+func (m *Client) Transactions(prefill ...*processout.Transaction) processout.TransactionService {
+	if m.TransactionsFunc == nil {
+		panic("processoutmock: Client.Transactions is not mocked")
+	}
+	return m.TransactionsFunc(prefill...)
+}
+
+// Webhooks calls WebhooksFunc
+func (m *Client) Webhooks(prefill ...*processout.Webhook) processout.WebhookService {
+	if m.WebhooksFunc == nil {
+		panic("processoutmock: Client.Webhooks is not mocked")
+	}
+	return m.WebhooksFunc(prefill...)
+}
+
+// WebhookEndpoints calls WebhookEndpointsFunc
+func (m *Client) WebhookEndpoints(prefill ...*processout.WebhookEndpoint) processout.WebhookEndpointService {
+	if m.WebhookEndpointsFunc == nil {
+		panic("processoutmock: Client.WebhookEndpoints is not mocked")
+	}
+	return m.WebhookEndpointsFunc(prefill...)
+}
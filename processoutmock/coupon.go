@@ -0,0 +1,160 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// CouponService is a mock of processout.CouponService. Each method calls the
+// function of the same name. The methods without a context fall back to the
+// function of their WithContext variant, and the other way around, so that
+// only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type CouponService struct {
+	AllFunc                 func(options ...processout.CouponAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc      func(ctx context.Context, options ...processout.CouponAllParameters) (*processout.Iterator, error)
+	AllTypedFunc            func(options ...processout.CouponAllParameters) (*processout.TypedIterator[*processout.Coupon], error)
+	AllTypedWithContextFunc func(ctx context.Context, options ...processout.CouponAllParameters) (*processout.TypedIterator[*processout.Coupon], error)
+	CreateFunc              func(options ...processout.CouponCreateParameters) (*processout.Coupon, error)
+	CreateWithContextFunc   func(ctx context.Context, options ...processout.CouponCreateParameters) (*processout.Coupon, error)
+	FindFunc                func(couponID string, options ...processout.CouponFindParameters) (*processout.Coupon, error)
+	FindWithContextFunc     func(ctx context.Context, couponID string, options ...processout.CouponFindParameters) (*processout.Coupon, error)
+	SaveFunc                func(options ...processout.CouponSaveParameters) (*processout.Coupon, error)
+	SaveWithContextFunc     func(ctx context.Context, options ...processout.CouponSaveParameters) (*processout.Coupon, error)
+	DeleteFunc              func(options ...processout.CouponDeleteParameters) error
+	DeleteWithContextFunc   func(ctx context.Context, options ...processout.CouponDeleteParameters) error
+}
+
+var _ processout.CouponService = &CouponService{}
+
+// All calls AllFunc
+func (m *CouponService) All(options ...processout.CouponAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *CouponService) AllWithContext(ctx context.Context, options ...processout.CouponAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: CouponService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *CouponService) AllTyped(options ...processout.CouponAllParameters) (*processout.TypedIterator[*processout.Coupon], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *CouponService) AllTypedWithContext(ctx context.Context, options ...processout.CouponAllParameters) (*processout.TypedIterator[*processout.Coupon], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Coupon]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *CouponService) Create(options ...processout.CouponCreateParameters) (*processout.Coupon, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *CouponService) CreateWithContext(ctx context.Context, options ...processout.CouponCreateParameters) (*processout.Coupon, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: CouponService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *CouponService) Find(couponID string, options ...processout.CouponFindParameters) (*processout.Coupon, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(couponID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), couponID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *CouponService) FindWithContext(ctx context.Context, couponID string, options ...processout.CouponFindParameters) (*processout.Coupon, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, couponID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(couponID, options...)
+	}
+
+	panic("processoutmock: CouponService.Find is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *CouponService) Save(options ...processout.CouponSaveParameters) (*processout.Coupon, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *CouponService) SaveWithContext(ctx context.Context, options ...processout.CouponSaveParameters) (*processout.Coupon, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: CouponService.Save is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *CouponService) Delete(options ...processout.CouponDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *CouponService) DeleteWithContext(ctx context.Context, options ...processout.CouponDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: CouponService.Delete is not mocked")
+}
@@ -0,0 +1,356 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// CustomerService is a mock of processout.CustomerService. Each method calls
+// the function of the same name. The methods without a context fall back to
+// the function of their WithContext variant, and the other way around, so
+// that only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type CustomerService struct {
+	FetchSubscriptionsFunc                 func(options ...processout.CustomerFetchSubscriptionsParameters) (*processout.Iterator, error)
+	FetchSubscriptionsWithContextFunc      func(ctx context.Context, options ...processout.CustomerFetchSubscriptionsParameters) (*processout.Iterator, error)
+	FetchSubscriptionsTypedFunc            func(options ...processout.CustomerFetchSubscriptionsParameters) (*processout.TypedIterator[*processout.Subscription], error)
+	FetchSubscriptionsTypedWithContextFunc func(ctx context.Context, options ...processout.CustomerFetchSubscriptionsParameters) (*processout.TypedIterator[*processout.Subscription], error)
+	FetchTokensFunc                        func(options ...processout.CustomerFetchTokensParameters) (*processout.Iterator, error)
+	FetchTokensWithContextFunc             func(ctx context.Context, options ...processout.CustomerFetchTokensParameters) (*processout.Iterator, error)
+	FetchTokensTypedFunc                   func(options ...processout.CustomerFetchTokensParameters) (*processout.TypedIterator[*processout.Token], error)
+	FetchTokensTypedWithContextFunc        func(ctx context.Context, options ...processout.CustomerFetchTokensParameters) (*processout.TypedIterator[*processout.Token], error)
+	FindTokenFunc                          func(tokenID string, options ...processout.CustomerFindTokenParameters) (*processout.Token, error)
+	FindTokenWithContextFunc               func(ctx context.Context, tokenID string, options ...processout.CustomerFindTokenParameters) (*processout.Token, error)
+	DeleteTokenFunc                        func(tokenID string, options ...processout.CustomerDeleteTokenParameters) error
+	DeleteTokenWithContextFunc             func(ctx context.Context, tokenID string, options ...processout.CustomerDeleteTokenParameters) error
+	FetchTransactionsFunc                  func(options ...processout.CustomerFetchTransactionsParameters) (*processout.Iterator, error)
+	FetchTransactionsWithContextFunc       func(ctx context.Context, options ...processout.CustomerFetchTransactionsParameters) (*processout.Iterator, error)
+	FetchTransactionsTypedFunc             func(options ...processout.CustomerFetchTransactionsParameters) (*processout.TypedIterator[*processout.Transaction], error)
+	FetchTransactionsTypedWithContextFunc  func(ctx context.Context, options ...processout.CustomerFetchTransactionsParameters) (*processout.TypedIterator[*processout.Transaction], error)
+	AllFunc                                func(options ...processout.CustomerAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc                     func(ctx context.Context, options ...processout.CustomerAllParameters) (*processout.Iterator, error)
+	AllTypedFunc                           func(options ...processout.CustomerAllParameters) (*processout.TypedIterator[*processout.Customer], error)
+	AllTypedWithContextFunc                func(ctx context.Context, options ...processout.CustomerAllParameters) (*processout.TypedIterator[*processout.Customer], error)
+	CreateFunc                             func(options ...processout.CustomerCreateParameters) (*processout.Customer, error)
+	CreateWithContextFunc                  func(ctx context.Context, options ...processout.CustomerCreateParameters) (*processout.Customer, error)
+	FindFunc                               func(customerID string, options ...processout.CustomerFindParameters) (*processout.Customer, error)
+	FindWithContextFunc                    func(ctx context.Context, customerID string, options ...processout.CustomerFindParameters) (*processout.Customer, error)
+	SaveFunc                               func(options ...processout.CustomerSaveParameters) (*processout.Customer, error)
+	SaveWithContextFunc                    func(ctx context.Context, options ...processout.CustomerSaveParameters) (*processout.Customer, error)
+	DeleteFunc                             func(options ...processout.CustomerDeleteParameters) error
+	DeleteWithContextFunc                  func(ctx context.Context, options ...processout.CustomerDeleteParameters) error
+}
+
+var _ processout.CustomerService = &CustomerService{}
+
+// FetchSubscriptions calls FetchSubscriptionsFunc
+func (m *CustomerService) FetchSubscriptions(options ...processout.CustomerFetchSubscriptionsParameters) (*processout.Iterator, error) {
+	if m.FetchSubscriptionsFunc != nil {
+		return m.FetchSubscriptionsFunc(options...)
+	}
+
+	return m.FetchSubscriptionsWithContext(context.Background(), options...)
+}
+
+// FetchSubscriptionsWithContext calls FetchSubscriptionsWithContextFunc
+func (m *CustomerService) FetchSubscriptionsWithContext(ctx context.Context, options ...processout.CustomerFetchSubscriptionsParameters) (*processout.Iterator, error) {
+	if m.FetchSubscriptionsWithContextFunc != nil {
+		return m.FetchSubscriptionsWithContextFunc(ctx, options...)
+	}
+	if m.FetchSubscriptionsFunc != nil {
+		return m.FetchSubscriptionsFunc(options...)
+	}
+
+	panic("processoutmock: CustomerService.FetchSubscriptions is not mocked")
+}
+
+// FetchSubscriptionsTyped calls FetchSubscriptionsTypedFunc
+func (m *CustomerService) FetchSubscriptionsTyped(options ...processout.CustomerFetchSubscriptionsParameters) (*processout.TypedIterator[*processout.Subscription], error) {
+	if m.FetchSubscriptionsTypedFunc != nil {
+		return m.FetchSubscriptionsTypedFunc(options...)
+	}
+
+	return m.FetchSubscriptionsTypedWithContext(context.Background(), options...)
+}
+
+// FetchSubscriptionsTypedWithContext calls FetchSubscriptionsTypedWithContextFunc
+func (m *CustomerService) FetchSubscriptionsTypedWithContext(ctx context.Context, options ...processout.CustomerFetchSubscriptionsParameters) (*processout.TypedIterator[*processout.Subscription], error) {
+	if m.FetchSubscriptionsTypedWithContextFunc != nil {
+		return m.FetchSubscriptionsTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchSubscriptionsTypedFunc != nil {
+		return m.FetchSubscriptionsTypedFunc(options...)
+	}
+
+	it, err := m.FetchSubscriptionsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Subscription]{Iterator: it}, nil
+}
+
+// FetchTokens calls FetchTokensFunc
+func (m *CustomerService) FetchTokens(options ...processout.CustomerFetchTokensParameters) (*processout.Iterator, error) {
+	if m.FetchTokensFunc != nil {
+		return m.FetchTokensFunc(options...)
+	}
+
+	return m.FetchTokensWithContext(context.Background(), options...)
+}
+
+// FetchTokensWithContext calls FetchTokensWithContextFunc
+func (m *CustomerService) FetchTokensWithContext(ctx context.Context, options ...processout.CustomerFetchTokensParameters) (*processout.Iterator, error) {
+	if m.FetchTokensWithContextFunc != nil {
+		return m.FetchTokensWithContextFunc(ctx, options...)
+	}
+	if m.FetchTokensFunc != nil {
+		return m.FetchTokensFunc(options...)
+	}
+
+	panic("processoutmock: CustomerService.FetchTokens is not mocked")
+}
+
+// FetchTokensTyped calls FetchTokensTypedFunc
+func (m *CustomerService) FetchTokensTyped(options ...processout.CustomerFetchTokensParameters) (*processout.TypedIterator[*processout.Token], error) {
+	if m.FetchTokensTypedFunc != nil {
+		return m.FetchTokensTypedFunc(options...)
+	}
+
+	return m.FetchTokensTypedWithContext(context.Background(), options...)
+}
+
+// FetchTokensTypedWithContext calls FetchTokensTypedWithContextFunc
+func (m *CustomerService) FetchTokensTypedWithContext(ctx context.Context, options ...processout.CustomerFetchTokensParameters) (*processout.TypedIterator[*processout.Token], error) {
+	if m.FetchTokensTypedWithContextFunc != nil {
+		return m.FetchTokensTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchTokensTypedFunc != nil {
+		return m.FetchTokensTypedFunc(options...)
+	}
+
+	it, err := m.FetchTokensWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Token]{Iterator: it}, nil
+}
+
+// FindToken calls FindTokenFunc
+func (m *CustomerService) FindToken(tokenID string, options ...processout.CustomerFindTokenParameters) (*processout.Token, error) {
+	if m.FindTokenFunc != nil {
+		return m.FindTokenFunc(tokenID, options...)
+	}
+
+	return m.FindTokenWithContext(context.Background(), tokenID, options...)
+}
+
+// FindTokenWithContext calls FindTokenWithContextFunc
+func (m *CustomerService) FindTokenWithContext(ctx context.Context, tokenID string, options ...processout.CustomerFindTokenParameters) (*processout.Token, error) {
+	if m.FindTokenWithContextFunc != nil {
+		return m.FindTokenWithContextFunc(ctx, tokenID, options...)
+	}
+	if m.FindTokenFunc != nil {
+		return m.FindTokenFunc(tokenID, options...)
+	}
+
+	panic("processoutmock: CustomerService.FindToken is not mocked")
+}
+
+// DeleteToken calls DeleteTokenFunc
+func (m *CustomerService) DeleteToken(tokenID string, options ...processout.CustomerDeleteTokenParameters) error {
+	if m.DeleteTokenFunc != nil {
+		return m.DeleteTokenFunc(tokenID, options...)
+	}
+
+	return m.DeleteTokenWithContext(context.Background(), tokenID, options...)
+}
+
+// DeleteTokenWithContext calls DeleteTokenWithContextFunc
+func (m *CustomerService) DeleteTokenWithContext(ctx context.Context, tokenID string, options ...processout.CustomerDeleteTokenParameters) error {
+	if m.DeleteTokenWithContextFunc != nil {
+		return m.DeleteTokenWithContextFunc(ctx, tokenID, options...)
+	}
+	if m.DeleteTokenFunc != nil {
+		return m.DeleteTokenFunc(tokenID, options...)
+	}
+
+	panic("processoutmock: CustomerService.DeleteToken is not mocked")
+}
+
+// FetchTransactions calls FetchTransactionsFunc
+func (m *CustomerService) FetchTransactions(options ...processout.CustomerFetchTransactionsParameters) (*processout.Iterator, error) {
+	if m.FetchTransactionsFunc != nil {
+		return m.FetchTransactionsFunc(options...)
+	}
+
+	return m.FetchTransactionsWithContext(context.Background(), options...)
+}
+
+// FetchTransactionsWithContext calls FetchTransactionsWithContextFunc
+func (m *CustomerService) FetchTransactionsWithContext(ctx context.Context, options ...processout.CustomerFetchTransactionsParameters) (*processout.Iterator, error) {
+	if m.FetchTransactionsWithContextFunc != nil {
+		return m.FetchTransactionsWithContextFunc(ctx, options...)
+	}
+	if m.FetchTransactionsFunc != nil {
+		return m.FetchTransactionsFunc(options...)
+	}
+
+	panic("processoutmock: CustomerService.FetchTransactions is not mocked")
+}
+
+// FetchTransactionsTyped calls FetchTransactionsTypedFunc
+func (m *CustomerService) FetchTransactionsTyped(options ...processout.CustomerFetchTransactionsParameters) (*processout.TypedIterator[*processout.Transaction], error) {
+	if m.FetchTransactionsTypedFunc != nil {
+		return m.FetchTransactionsTypedFunc(options...)
+	}
+
+	return m.FetchTransactionsTypedWithContext(context.Background(), options...)
+}
+
+// FetchTransactionsTypedWithContext calls FetchTransactionsTypedWithContextFunc
+func (m *CustomerService) FetchTransactionsTypedWithContext(ctx context.Context, options ...processout.CustomerFetchTransactionsParameters) (*processout.TypedIterator[*processout.Transaction], error) {
+	if m.FetchTransactionsTypedWithContextFunc != nil {
+		return m.FetchTransactionsTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchTransactionsTypedFunc != nil {
+		return m.FetchTransactionsTypedFunc(options...)
+	}
+
+	it, err := m.FetchTransactionsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Transaction]{Iterator: it}, nil
+}
+
+// All calls AllFunc
+func (m *CustomerService) All(options ...processout.CustomerAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *CustomerService) AllWithContext(ctx context.Context, options ...processout.CustomerAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: CustomerService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *CustomerService) AllTyped(options ...processout.CustomerAllParameters) (*processout.TypedIterator[*processout.Customer], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *CustomerService) AllTypedWithContext(ctx context.Context, options ...processout.CustomerAllParameters) (*processout.TypedIterator[*processout.Customer], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Customer]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *CustomerService) Create(options ...processout.CustomerCreateParameters) (*processout.Customer, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *CustomerService) CreateWithContext(ctx context.Context, options ...processout.CustomerCreateParameters) (*processout.Customer, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: CustomerService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *CustomerService) Find(customerID string, options ...processout.CustomerFindParameters) (*processout.Customer, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(customerID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), customerID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *CustomerService) FindWithContext(ctx context.Context, customerID string, options ...processout.CustomerFindParameters) (*processout.Customer, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, customerID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(customerID, options...)
+	}
+
+	panic("processoutmock: CustomerService.Find is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *CustomerService) Save(options ...processout.CustomerSaveParameters) (*processout.Customer, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *CustomerService) SaveWithContext(ctx context.Context, options ...processout.CustomerSaveParameters) (*processout.Customer, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: CustomerService.Save is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *CustomerService) Delete(options ...processout.CustomerDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *CustomerService) DeleteWithContext(ctx context.Context, options ...processout.CustomerDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: CustomerService.Delete is not mocked")
+}
@@ -0,0 +1,137 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// DiscountService is a mock of processout.DiscountService. Each method calls
+// the function of the same name. The methods without a context fall back to
+// the function of their WithContext variant, and the other way around, so
+// that only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type DiscountService struct {
+	FetchSubscriptionDiscountsFunc                 func(subscriptionID string, options ...processout.DiscountFetchSubscriptionDiscountsParameters) (*processout.Iterator, error)
+	FetchSubscriptionDiscountsWithContextFunc      func(ctx context.Context, subscriptionID string, options ...processout.DiscountFetchSubscriptionDiscountsParameters) (*processout.Iterator, error)
+	FetchSubscriptionDiscountsTypedFunc            func(subscriptionID string, options ...processout.DiscountFetchSubscriptionDiscountsParameters) (*processout.TypedIterator[*processout.Discount], error)
+	FetchSubscriptionDiscountsTypedWithContextFunc func(ctx context.Context, subscriptionID string, options ...processout.DiscountFetchSubscriptionDiscountsParameters) (*processout.TypedIterator[*processout.Discount], error)
+	CreateFunc                                     func(options ...processout.DiscountCreateParameters) (*processout.Discount, error)
+	CreateWithContextFunc                          func(ctx context.Context, options ...processout.DiscountCreateParameters) (*processout.Discount, error)
+	FindFunc                                       func(subscriptionID, discountID string, options ...processout.DiscountFindParameters) (*processout.Discount, error)
+	FindWithContextFunc                            func(ctx context.Context, subscriptionID, discountID string, options ...processout.DiscountFindParameters) (*processout.Discount, error)
+	DeleteFunc                                     func(options ...processout.DiscountDeleteParameters) error
+	DeleteWithContextFunc                          func(ctx context.Context, options ...processout.DiscountDeleteParameters) error
+}
+
+var _ processout.DiscountService = &DiscountService{}
+
+// FetchSubscriptionDiscounts calls FetchSubscriptionDiscountsFunc
+func (m *DiscountService) FetchSubscriptionDiscounts(subscriptionID string, options ...processout.DiscountFetchSubscriptionDiscountsParameters) (*processout.Iterator, error) {
+	if m.FetchSubscriptionDiscountsFunc != nil {
+		return m.FetchSubscriptionDiscountsFunc(subscriptionID, options...)
+	}
+
+	return m.FetchSubscriptionDiscountsWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FetchSubscriptionDiscountsWithContext calls FetchSubscriptionDiscountsWithContextFunc
+func (m *DiscountService) FetchSubscriptionDiscountsWithContext(ctx context.Context, subscriptionID string, options ...processout.DiscountFetchSubscriptionDiscountsParameters) (*processout.Iterator, error) {
+	if m.FetchSubscriptionDiscountsWithContextFunc != nil {
+		return m.FetchSubscriptionDiscountsWithContextFunc(ctx, subscriptionID, options...)
+	}
+	if m.FetchSubscriptionDiscountsFunc != nil {
+		return m.FetchSubscriptionDiscountsFunc(subscriptionID, options...)
+	}
+
+	panic("processoutmock: DiscountService.FetchSubscriptionDiscounts is not mocked")
+}
+
+// FetchSubscriptionDiscountsTyped calls FetchSubscriptionDiscountsTypedFunc
+func (m *DiscountService) FetchSubscriptionDiscountsTyped(subscriptionID string, options ...processout.DiscountFetchSubscriptionDiscountsParameters) (*processout.TypedIterator[*processout.Discount], error) {
+	if m.FetchSubscriptionDiscountsTypedFunc != nil {
+		return m.FetchSubscriptionDiscountsTypedFunc(subscriptionID, options...)
+	}
+
+	return m.FetchSubscriptionDiscountsTypedWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FetchSubscriptionDiscountsTypedWithContext calls FetchSubscriptionDiscountsTypedWithContextFunc
+func (m *DiscountService) FetchSubscriptionDiscountsTypedWithContext(ctx context.Context, subscriptionID string, options ...processout.DiscountFetchSubscriptionDiscountsParameters) (*processout.TypedIterator[*processout.Discount], error) {
+	if m.FetchSubscriptionDiscountsTypedWithContextFunc != nil {
+		return m.FetchSubscriptionDiscountsTypedWithContextFunc(ctx, subscriptionID, options...)
+	}
+	if m.FetchSubscriptionDiscountsTypedFunc != nil {
+		return m.FetchSubscriptionDiscountsTypedFunc(subscriptionID, options...)
+	}
+
+	it, err := m.FetchSubscriptionDiscountsWithContext(ctx, subscriptionID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Discount]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *DiscountService) Create(options ...processout.DiscountCreateParameters) (*processout.Discount, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *DiscountService) CreateWithContext(ctx context.Context, options ...processout.DiscountCreateParameters) (*processout.Discount, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: DiscountService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *DiscountService) Find(subscriptionID, discountID string, options ...processout.DiscountFindParameters) (*processout.Discount, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(subscriptionID, discountID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), subscriptionID, discountID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *DiscountService) FindWithContext(ctx context.Context, subscriptionID, discountID string, options ...processout.DiscountFindParameters) (*processout.Discount, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, subscriptionID, discountID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(subscriptionID, discountID, options...)
+	}
+
+	panic("processoutmock: DiscountService.Find is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *DiscountService) Delete(options ...processout.DiscountDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *DiscountService) DeleteWithContext(ctx context.Context, options ...processout.DiscountDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: DiscountService.Delete is not mocked")
+}
@@ -0,0 +1,18 @@
+// Package processoutmock provides mocks of the interfaces describing the
+// ProcessOut client and the services of its resources, so that the code
+// using them can be tested without calling the API:
+//
+//	client := &processoutmock.Client{
+//		InvoicesFunc: func(prefill ...*processout.Invoice) processout.InvoiceService {
+//			return &processoutmock.InvoiceService{
+//				CaptureFunc: func(source string, options ...processout.InvoiceCaptureParameters) (*processout.Transaction, error) {
+//					return &processout.Transaction{Status: processout.String("completed")}, nil
+//				},
+//			}
+//		},
+//	}
+//
+// The code under test should then use the processout.Client interface, and
+// call the methods of the services it returns, such as
+// client.Invoices(iv).Capture(source), instead of iv.Capture(source).
+package processoutmock
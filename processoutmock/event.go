@@ -0,0 +1,141 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// EventService is a mock of processout.EventService. Each method calls the
+// function of the same name. The methods without a context fall back to the
+// function of their WithContext variant, and the other way around, so that
+// only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type EventService struct {
+	FetchWebhooksFunc                 func(options ...processout.EventFetchWebhooksParameters) (*processout.Iterator, error)
+	FetchWebhooksWithContextFunc      func(ctx context.Context, options ...processout.EventFetchWebhooksParameters) (*processout.Iterator, error)
+	FetchWebhooksTypedFunc            func(options ...processout.EventFetchWebhooksParameters) (*processout.TypedIterator[*processout.Webhook], error)
+	FetchWebhooksTypedWithContextFunc func(ctx context.Context, options ...processout.EventFetchWebhooksParameters) (*processout.TypedIterator[*processout.Webhook], error)
+	AllFunc                           func(options ...processout.EventAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc                func(ctx context.Context, options ...processout.EventAllParameters) (*processout.Iterator, error)
+	AllTypedFunc                      func(options ...processout.EventAllParameters) (*processout.TypedIterator[*processout.Event], error)
+	AllTypedWithContextFunc           func(ctx context.Context, options ...processout.EventAllParameters) (*processout.TypedIterator[*processout.Event], error)
+	FindFunc                          func(eventID string, options ...processout.EventFindParameters) (*processout.Event, error)
+	FindWithContextFunc               func(ctx context.Context, eventID string, options ...processout.EventFindParameters) (*processout.Event, error)
+}
+
+var _ processout.EventService = &EventService{}
+
+// FetchWebhooks calls FetchWebhooksFunc
+func (m *EventService) FetchWebhooks(options ...processout.EventFetchWebhooksParameters) (*processout.Iterator, error) {
+	if m.FetchWebhooksFunc != nil {
+		return m.FetchWebhooksFunc(options...)
+	}
+
+	return m.FetchWebhooksWithContext(context.Background(), options...)
+}
+
+// FetchWebhooksWithContext calls FetchWebhooksWithContextFunc
+func (m *EventService) FetchWebhooksWithContext(ctx context.Context, options ...processout.EventFetchWebhooksParameters) (*processout.Iterator, error) {
+	if m.FetchWebhooksWithContextFunc != nil {
+		return m.FetchWebhooksWithContextFunc(ctx, options...)
+	}
+	if m.FetchWebhooksFunc != nil {
+		return m.FetchWebhooksFunc(options...)
+	}
+
+	panic("processoutmock: EventService.FetchWebhooks is not mocked")
+}
+
+// FetchWebhooksTyped calls FetchWebhooksTypedFunc
+func (m *EventService) FetchWebhooksTyped(options ...processout.EventFetchWebhooksParameters) (*processout.TypedIterator[*processout.Webhook], error) {
+	if m.FetchWebhooksTypedFunc != nil {
+		return m.FetchWebhooksTypedFunc(options...)
+	}
+
+	return m.FetchWebhooksTypedWithContext(context.Background(), options...)
+}
+
+// FetchWebhooksTypedWithContext calls FetchWebhooksTypedWithContextFunc
+func (m *EventService) FetchWebhooksTypedWithContext(ctx context.Context, options ...processout.EventFetchWebhooksParameters) (*processout.TypedIterator[*processout.Webhook], error) {
+	if m.FetchWebhooksTypedWithContextFunc != nil {
+		return m.FetchWebhooksTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchWebhooksTypedFunc != nil {
+		return m.FetchWebhooksTypedFunc(options...)
+	}
+
+	it, err := m.FetchWebhooksWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Webhook]{Iterator: it}, nil
+}
+
+// All calls AllFunc
+func (m *EventService) All(options ...processout.EventAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *EventService) AllWithContext(ctx context.Context, options ...processout.EventAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: EventService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *EventService) AllTyped(options ...processout.EventAllParameters) (*processout.TypedIterator[*processout.Event], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *EventService) AllTypedWithContext(ctx context.Context, options ...processout.EventAllParameters) (*processout.TypedIterator[*processout.Event], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Event]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *EventService) Find(eventID string, options ...processout.EventFindParameters) (*processout.Event, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(eventID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), eventID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *EventService) FindWithContext(ctx context.Context, eventID string, options ...processout.EventFindParameters) (*processout.Event, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, eventID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(eventID, options...)
+	}
+
+	panic("processoutmock: EventService.Find is not mocked")
+}
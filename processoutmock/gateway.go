@@ -0,0 +1,68 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// GatewayService is a mock of processout.GatewayService. Each method calls
+// the function of the same name. The methods without a context fall back to
+// the function of their WithContext variant, and the other way around, so
+// that only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type GatewayService struct {
+	FetchGatewayConfigurationsFunc                 func(options ...processout.GatewayFetchGatewayConfigurationsParameters) (*processout.Iterator, error)
+	FetchGatewayConfigurationsWithContextFunc      func(ctx context.Context, options ...processout.GatewayFetchGatewayConfigurationsParameters) (*processout.Iterator, error)
+	FetchGatewayConfigurationsTypedFunc            func(options ...processout.GatewayFetchGatewayConfigurationsParameters) (*processout.TypedIterator[*processout.GatewayConfiguration], error)
+	FetchGatewayConfigurationsTypedWithContextFunc func(ctx context.Context, options ...processout.GatewayFetchGatewayConfigurationsParameters) (*processout.TypedIterator[*processout.GatewayConfiguration], error)
+}
+
+var _ processout.GatewayService = &GatewayService{}
+
+// FetchGatewayConfigurations calls FetchGatewayConfigurationsFunc
+func (m *GatewayService) FetchGatewayConfigurations(options ...processout.GatewayFetchGatewayConfigurationsParameters) (*processout.Iterator, error) {
+	if m.FetchGatewayConfigurationsFunc != nil {
+		return m.FetchGatewayConfigurationsFunc(options...)
+	}
+
+	return m.FetchGatewayConfigurationsWithContext(context.Background(), options...)
+}
+
+// FetchGatewayConfigurationsWithContext calls FetchGatewayConfigurationsWithContextFunc
+func (m *GatewayService) FetchGatewayConfigurationsWithContext(ctx context.Context, options ...processout.GatewayFetchGatewayConfigurationsParameters) (*processout.Iterator, error) {
+	if m.FetchGatewayConfigurationsWithContextFunc != nil {
+		return m.FetchGatewayConfigurationsWithContextFunc(ctx, options...)
+	}
+	if m.FetchGatewayConfigurationsFunc != nil {
+		return m.FetchGatewayConfigurationsFunc(options...)
+	}
+
+	panic("processoutmock: GatewayService.FetchGatewayConfigurations is not mocked")
+}
+
+// FetchGatewayConfigurationsTyped calls FetchGatewayConfigurationsTypedFunc
+func (m *GatewayService) FetchGatewayConfigurationsTyped(options ...processout.GatewayFetchGatewayConfigurationsParameters) (*processout.TypedIterator[*processout.GatewayConfiguration], error) {
+	if m.FetchGatewayConfigurationsTypedFunc != nil {
+		return m.FetchGatewayConfigurationsTypedFunc(options...)
+	}
+
+	return m.FetchGatewayConfigurationsTypedWithContext(context.Background(), options...)
+}
+
+// FetchGatewayConfigurationsTypedWithContext calls FetchGatewayConfigurationsTypedWithContextFunc
+func (m *GatewayService) FetchGatewayConfigurationsTypedWithContext(ctx context.Context, options ...processout.GatewayFetchGatewayConfigurationsParameters) (*processout.TypedIterator[*processout.GatewayConfiguration], error) {
+	if m.FetchGatewayConfigurationsTypedWithContextFunc != nil {
+		return m.FetchGatewayConfigurationsTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchGatewayConfigurationsTypedFunc != nil {
+		return m.FetchGatewayConfigurationsTypedFunc(options...)
+	}
+
+	it, err := m.FetchGatewayConfigurationsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.GatewayConfiguration]{Iterator: it}, nil
+}
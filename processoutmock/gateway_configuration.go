@@ -0,0 +1,160 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// GatewayConfigurationService is a mock of
+// processout.GatewayConfigurationService. Each method calls the function of
+// the same name. The methods without a context fall back to the function of
+// their WithContext variant, and the other way around, so that only one of
+// them needs to be set. The Typed variants fall back to the functions of the
+// untyped ones. Calling a method whose function isn't set panics
+type GatewayConfigurationService struct {
+	AllFunc                 func(options ...processout.GatewayConfigurationAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc      func(ctx context.Context, options ...processout.GatewayConfigurationAllParameters) (*processout.Iterator, error)
+	AllTypedFunc            func(options ...processout.GatewayConfigurationAllParameters) (*processout.TypedIterator[*processout.GatewayConfiguration], error)
+	AllTypedWithContextFunc func(ctx context.Context, options ...processout.GatewayConfigurationAllParameters) (*processout.TypedIterator[*processout.GatewayConfiguration], error)
+	FindFunc                func(configurationID string, options ...processout.GatewayConfigurationFindParameters) (*processout.GatewayConfiguration, error)
+	FindWithContextFunc     func(ctx context.Context, configurationID string, options ...processout.GatewayConfigurationFindParameters) (*processout.GatewayConfiguration, error)
+	SaveFunc                func(options ...processout.GatewayConfigurationSaveParameters) (*processout.GatewayConfiguration, error)
+	SaveWithContextFunc     func(ctx context.Context, options ...processout.GatewayConfigurationSaveParameters) (*processout.GatewayConfiguration, error)
+	DeleteFunc              func(options ...processout.GatewayConfigurationDeleteParameters) error
+	DeleteWithContextFunc   func(ctx context.Context, options ...processout.GatewayConfigurationDeleteParameters) error
+	CreateFunc              func(gatewayName string, options ...processout.GatewayConfigurationCreateParameters) (*processout.GatewayConfiguration, error)
+	CreateWithContextFunc   func(ctx context.Context, gatewayName string, options ...processout.GatewayConfigurationCreateParameters) (*processout.GatewayConfiguration, error)
+}
+
+var _ processout.GatewayConfigurationService = &GatewayConfigurationService{}
+
+// All calls AllFunc
+func (m *GatewayConfigurationService) All(options ...processout.GatewayConfigurationAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *GatewayConfigurationService) AllWithContext(ctx context.Context, options ...processout.GatewayConfigurationAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: GatewayConfigurationService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *GatewayConfigurationService) AllTyped(options ...processout.GatewayConfigurationAllParameters) (*processout.TypedIterator[*processout.GatewayConfiguration], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *GatewayConfigurationService) AllTypedWithContext(ctx context.Context, options ...processout.GatewayConfigurationAllParameters) (*processout.TypedIterator[*processout.GatewayConfiguration], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.GatewayConfiguration]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *GatewayConfigurationService) Find(configurationID string, options ...processout.GatewayConfigurationFindParameters) (*processout.GatewayConfiguration, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(configurationID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), configurationID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *GatewayConfigurationService) FindWithContext(ctx context.Context, configurationID string, options ...processout.GatewayConfigurationFindParameters) (*processout.GatewayConfiguration, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, configurationID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(configurationID, options...)
+	}
+
+	panic("processoutmock: GatewayConfigurationService.Find is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *GatewayConfigurationService) Save(options ...processout.GatewayConfigurationSaveParameters) (*processout.GatewayConfiguration, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *GatewayConfigurationService) SaveWithContext(ctx context.Context, options ...processout.GatewayConfigurationSaveParameters) (*processout.GatewayConfiguration, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: GatewayConfigurationService.Save is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *GatewayConfigurationService) Delete(options ...processout.GatewayConfigurationDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *GatewayConfigurationService) DeleteWithContext(ctx context.Context, options ...processout.GatewayConfigurationDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: GatewayConfigurationService.Delete is not mocked")
+}
+
+// Create calls CreateFunc
+func (m *GatewayConfigurationService) Create(gatewayName string, options ...processout.GatewayConfigurationCreateParameters) (*processout.GatewayConfiguration, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(gatewayName, options...)
+	}
+
+	return m.CreateWithContext(context.Background(), gatewayName, options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *GatewayConfigurationService) CreateWithContext(ctx context.Context, gatewayName string, options ...processout.GatewayConfigurationCreateParameters) (*processout.GatewayConfiguration, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, gatewayName, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(gatewayName, options...)
+	}
+
+	panic("processoutmock: GatewayConfigurationService.Create is not mocked")
+}
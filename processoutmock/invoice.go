@@ -0,0 +1,275 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// InvoiceService is a mock of processout.InvoiceService. Each method calls
+// the function of the same name. The methods without a context fall back to
+// the function of their WithContext variant, and the other way around, so
+// that only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type InvoiceService struct {
+	AuthorizeFunc                   func(source string, options ...processout.InvoiceAuthorizeParameters) (*processout.Transaction, error)
+	AuthorizeWithContextFunc        func(ctx context.Context, source string, options ...processout.InvoiceAuthorizeParameters) (*processout.Transaction, error)
+	CaptureFunc                     func(source string, options ...processout.InvoiceCaptureParameters) (*processout.Transaction, error)
+	CaptureWithContextFunc          func(ctx context.Context, source string, options ...processout.InvoiceCaptureParameters) (*processout.Transaction, error)
+	FetchCustomerFunc               func(options ...processout.InvoiceFetchCustomerParameters) (*processout.Customer, error)
+	FetchCustomerWithContextFunc    func(ctx context.Context, options ...processout.InvoiceFetchCustomerParameters) (*processout.Customer, error)
+	AssignCustomerFunc              func(customerID string, options ...processout.InvoiceAssignCustomerParameters) (*processout.Customer, error)
+	AssignCustomerWithContextFunc   func(ctx context.Context, customerID string, options ...processout.InvoiceAssignCustomerParameters) (*processout.Customer, error)
+	InitiateThreeDSFunc             func(source string, options ...processout.InvoiceInitiateThreeDSParameters) (*processout.CustomerAction, error)
+	InitiateThreeDSWithContextFunc  func(ctx context.Context, source string, options ...processout.InvoiceInitiateThreeDSParameters) (*processout.CustomerAction, error)
+	FetchTransactionFunc            func(options ...processout.InvoiceFetchTransactionParameters) (*processout.Transaction, error)
+	FetchTransactionWithContextFunc func(ctx context.Context, options ...processout.InvoiceFetchTransactionParameters) (*processout.Transaction, error)
+	VoidFunc                        func(options ...processout.InvoiceVoidParameters) (*processout.Transaction, error)
+	VoidWithContextFunc             func(ctx context.Context, options ...processout.InvoiceVoidParameters) (*processout.Transaction, error)
+	AllFunc                         func(options ...processout.InvoiceAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc              func(ctx context.Context, options ...processout.InvoiceAllParameters) (*processout.Iterator, error)
+	AllTypedFunc                    func(options ...processout.InvoiceAllParameters) (*processout.TypedIterator[*processout.Invoice], error)
+	AllTypedWithContextFunc         func(ctx context.Context, options ...processout.InvoiceAllParameters) (*processout.TypedIterator[*processout.Invoice], error)
+	CreateFunc                      func(options ...processout.InvoiceCreateParameters) (*processout.Invoice, error)
+	CreateWithContextFunc           func(ctx context.Context, options ...processout.InvoiceCreateParameters) (*processout.Invoice, error)
+	FindFunc                        func(invoiceID string, options ...processout.InvoiceFindParameters) (*processout.Invoice, error)
+	FindWithContextFunc             func(ctx context.Context, invoiceID string, options ...processout.InvoiceFindParameters) (*processout.Invoice, error)
+}
+
+var _ processout.InvoiceService = &InvoiceService{}
+
+// Authorize calls AuthorizeFunc
+func (m *InvoiceService) Authorize(source string, options ...processout.InvoiceAuthorizeParameters) (*processout.Transaction, error) {
+	if m.AuthorizeFunc != nil {
+		return m.AuthorizeFunc(source, options...)
+	}
+
+	return m.AuthorizeWithContext(context.Background(), source, options...)
+}
+
+// AuthorizeWithContext calls AuthorizeWithContextFunc
+func (m *InvoiceService) AuthorizeWithContext(ctx context.Context, source string, options ...processout.InvoiceAuthorizeParameters) (*processout.Transaction, error) {
+	if m.AuthorizeWithContextFunc != nil {
+		return m.AuthorizeWithContextFunc(ctx, source, options...)
+	}
+	if m.AuthorizeFunc != nil {
+		return m.AuthorizeFunc(source, options...)
+	}
+
+	panic("processoutmock: InvoiceService.Authorize is not mocked")
+}
+
+// Capture calls CaptureFunc
+func (m *InvoiceService) Capture(source string, options ...processout.InvoiceCaptureParameters) (*processout.Transaction, error) {
+	if m.CaptureFunc != nil {
+		return m.CaptureFunc(source, options...)
+	}
+
+	return m.CaptureWithContext(context.Background(), source, options...)
+}
+
+// CaptureWithContext calls CaptureWithContextFunc
+func (m *InvoiceService) CaptureWithContext(ctx context.Context, source string, options ...processout.InvoiceCaptureParameters) (*processout.Transaction, error) {
+	if m.CaptureWithContextFunc != nil {
+		return m.CaptureWithContextFunc(ctx, source, options...)
+	}
+	if m.CaptureFunc != nil {
+		return m.CaptureFunc(source, options...)
+	}
+
+	panic("processoutmock: InvoiceService.Capture is not mocked")
+}
+
+// FetchCustomer calls FetchCustomerFunc
+func (m *InvoiceService) FetchCustomer(options ...processout.InvoiceFetchCustomerParameters) (*processout.Customer, error) {
+	if m.FetchCustomerFunc != nil {
+		return m.FetchCustomerFunc(options...)
+	}
+
+	return m.FetchCustomerWithContext(context.Background(), options...)
+}
+
+// FetchCustomerWithContext calls FetchCustomerWithContextFunc
+func (m *InvoiceService) FetchCustomerWithContext(ctx context.Context, options ...processout.InvoiceFetchCustomerParameters) (*processout.Customer, error) {
+	if m.FetchCustomerWithContextFunc != nil {
+		return m.FetchCustomerWithContextFunc(ctx, options...)
+	}
+	if m.FetchCustomerFunc != nil {
+		return m.FetchCustomerFunc(options...)
+	}
+
+	panic("processoutmock: InvoiceService.FetchCustomer is not mocked")
+}
+
+// AssignCustomer calls AssignCustomerFunc
+func (m *InvoiceService) AssignCustomer(customerID string, options ...processout.InvoiceAssignCustomerParameters) (*processout.Customer, error) {
+	if m.AssignCustomerFunc != nil {
+		return m.AssignCustomerFunc(customerID, options...)
+	}
+
+	return m.AssignCustomerWithContext(context.Background(), customerID, options...)
+}
+
+// AssignCustomerWithContext calls AssignCustomerWithContextFunc
+func (m *InvoiceService) AssignCustomerWithContext(ctx context.Context, customerID string, options ...processout.InvoiceAssignCustomerParameters) (*processout.Customer, error) {
+	if m.AssignCustomerWithContextFunc != nil {
+		return m.AssignCustomerWithContextFunc(ctx, customerID, options...)
+	}
+	if m.AssignCustomerFunc != nil {
+		return m.AssignCustomerFunc(customerID, options...)
+	}
+
+	panic("processoutmock: InvoiceService.AssignCustomer is not mocked")
+}
+
+// InitiateThreeDS calls InitiateThreeDSFunc
+func (m *InvoiceService) InitiateThreeDS(source string, options ...processout.InvoiceInitiateThreeDSParameters) (*processout.CustomerAction, error) {
+	if m.InitiateThreeDSFunc != nil {
+		return m.InitiateThreeDSFunc(source, options...)
+	}
+
+	return m.InitiateThreeDSWithContext(context.Background(), source, options...)
+}
+
+// InitiateThreeDSWithContext calls InitiateThreeDSWithContextFunc
+func (m *InvoiceService) InitiateThreeDSWithContext(ctx context.Context, source string, options ...processout.InvoiceInitiateThreeDSParameters) (*processout.CustomerAction, error) {
+	if m.InitiateThreeDSWithContextFunc != nil {
+		return m.InitiateThreeDSWithContextFunc(ctx, source, options...)
+	}
+	if m.InitiateThreeDSFunc != nil {
+		return m.InitiateThreeDSFunc(source, options...)
+	}
+
+	panic("processoutmock: InvoiceService.InitiateThreeDS is not mocked")
+}
+
+// FetchTransaction calls FetchTransactionFunc
+func (m *InvoiceService) FetchTransaction(options ...processout.InvoiceFetchTransactionParameters) (*processout.Transaction, error) {
+	if m.FetchTransactionFunc != nil {
+		return m.FetchTransactionFunc(options...)
+	}
+
+	return m.FetchTransactionWithContext(context.Background(), options...)
+}
+
+// FetchTransactionWithContext calls FetchTransactionWithContextFunc
+func (m *InvoiceService) FetchTransactionWithContext(ctx context.Context, options ...processout.InvoiceFetchTransactionParameters) (*processout.Transaction, error) {
+	if m.FetchTransactionWithContextFunc != nil {
+		return m.FetchTransactionWithContextFunc(ctx, options...)
+	}
+	if m.FetchTransactionFunc != nil {
+		return m.FetchTransactionFunc(options...)
+	}
+
+	panic("processoutmock: InvoiceService.FetchTransaction is not mocked")
+}
+
+// Void calls VoidFunc
+func (m *InvoiceService) Void(options ...processout.InvoiceVoidParameters) (*processout.Transaction, error) {
+	if m.VoidFunc != nil {
+		return m.VoidFunc(options...)
+	}
+
+	return m.VoidWithContext(context.Background(), options...)
+}
+
+// VoidWithContext calls VoidWithContextFunc
+func (m *InvoiceService) VoidWithContext(ctx context.Context, options ...processout.InvoiceVoidParameters) (*processout.Transaction, error) {
+	if m.VoidWithContextFunc != nil {
+		return m.VoidWithContextFunc(ctx, options...)
+	}
+	if m.VoidFunc != nil {
+		return m.VoidFunc(options...)
+	}
+
+	panic("processoutmock: InvoiceService.Void is not mocked")
+}
+
+// All calls AllFunc
+func (m *InvoiceService) All(options ...processout.InvoiceAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *InvoiceService) AllWithContext(ctx context.Context, options ...processout.InvoiceAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: InvoiceService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *InvoiceService) AllTyped(options ...processout.InvoiceAllParameters) (*processout.TypedIterator[*processout.Invoice], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *InvoiceService) AllTypedWithContext(ctx context.Context, options ...processout.InvoiceAllParameters) (*processout.TypedIterator[*processout.Invoice], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Invoice]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *InvoiceService) Create(options ...processout.InvoiceCreateParameters) (*processout.Invoice, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *InvoiceService) CreateWithContext(ctx context.Context, options ...processout.InvoiceCreateParameters) (*processout.Invoice, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: InvoiceService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *InvoiceService) Find(invoiceID string, options ...processout.InvoiceFindParameters) (*processout.Invoice, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(invoiceID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), invoiceID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *InvoiceService) FindWithContext(ctx context.Context, invoiceID string, options ...processout.InvoiceFindParameters) (*processout.Invoice, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, invoiceID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(invoiceID, options...)
+	}
+
+	panic("processoutmock: InvoiceService.Find is not mocked")
+}
@@ -0,0 +1,141 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// PayoutService is a mock of processout.PayoutService. Each method calls the
+// function of the same name. The methods without a context fall back to the
+// function of their WithContext variant, and the other way around, so that
+// only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type PayoutService struct {
+	FetchItemsFunc                 func(options ...processout.PayoutFetchItemsParameters) (*processout.Iterator, error)
+	FetchItemsWithContextFunc      func(ctx context.Context, options ...processout.PayoutFetchItemsParameters) (*processout.Iterator, error)
+	FetchItemsTypedFunc            func(options ...processout.PayoutFetchItemsParameters) (*processout.TypedIterator[*processout.PayoutItem], error)
+	FetchItemsTypedWithContextFunc func(ctx context.Context, options ...processout.PayoutFetchItemsParameters) (*processout.TypedIterator[*processout.PayoutItem], error)
+	AllFunc                        func(options ...processout.PayoutAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc             func(ctx context.Context, options ...processout.PayoutAllParameters) (*processout.Iterator, error)
+	AllTypedFunc                   func(options ...processout.PayoutAllParameters) (*processout.TypedIterator[*processout.Payout], error)
+	AllTypedWithContextFunc        func(ctx context.Context, options ...processout.PayoutAllParameters) (*processout.TypedIterator[*processout.Payout], error)
+	FindFunc                       func(payoutID string, options ...processout.PayoutFindParameters) (*processout.Payout, error)
+	FindWithContextFunc            func(ctx context.Context, payoutID string, options ...processout.PayoutFindParameters) (*processout.Payout, error)
+}
+
+var _ processout.PayoutService = &PayoutService{}
+
+// FetchItems calls FetchItemsFunc
+func (m *PayoutService) FetchItems(options ...processout.PayoutFetchItemsParameters) (*processout.Iterator, error) {
+	if m.FetchItemsFunc != nil {
+		return m.FetchItemsFunc(options...)
+	}
+
+	return m.FetchItemsWithContext(context.Background(), options...)
+}
+
+// FetchItemsWithContext calls FetchItemsWithContextFunc
+func (m *PayoutService) FetchItemsWithContext(ctx context.Context, options ...processout.PayoutFetchItemsParameters) (*processout.Iterator, error) {
+	if m.FetchItemsWithContextFunc != nil {
+		return m.FetchItemsWithContextFunc(ctx, options...)
+	}
+	if m.FetchItemsFunc != nil {
+		return m.FetchItemsFunc(options...)
+	}
+
+	panic("processoutmock: PayoutService.FetchItems is not mocked")
+}
+
+// FetchItemsTyped calls FetchItemsTypedFunc
+func (m *PayoutService) FetchItemsTyped(options ...processout.PayoutFetchItemsParameters) (*processout.TypedIterator[*processout.PayoutItem], error) {
+	if m.FetchItemsTypedFunc != nil {
+		return m.FetchItemsTypedFunc(options...)
+	}
+
+	return m.FetchItemsTypedWithContext(context.Background(), options...)
+}
+
+// FetchItemsTypedWithContext calls FetchItemsTypedWithContextFunc
+func (m *PayoutService) FetchItemsTypedWithContext(ctx context.Context, options ...processout.PayoutFetchItemsParameters) (*processout.TypedIterator[*processout.PayoutItem], error) {
+	if m.FetchItemsTypedWithContextFunc != nil {
+		return m.FetchItemsTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchItemsTypedFunc != nil {
+		return m.FetchItemsTypedFunc(options...)
+	}
+
+	it, err := m.FetchItemsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.PayoutItem]{Iterator: it}, nil
+}
+
+// All calls AllFunc
+func (m *PayoutService) All(options ...processout.PayoutAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *PayoutService) AllWithContext(ctx context.Context, options ...processout.PayoutAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: PayoutService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *PayoutService) AllTyped(options ...processout.PayoutAllParameters) (*processout.TypedIterator[*processout.Payout], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *PayoutService) AllTypedWithContext(ctx context.Context, options ...processout.PayoutAllParameters) (*processout.TypedIterator[*processout.Payout], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Payout]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *PayoutService) Find(payoutID string, options ...processout.PayoutFindParameters) (*processout.Payout, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(payoutID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), payoutID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *PayoutService) FindWithContext(ctx context.Context, payoutID string, options ...processout.PayoutFindParameters) (*processout.Payout, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, payoutID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(payoutID, options...)
+	}
+
+	panic("processoutmock: PayoutService.Find is not mocked")
+}
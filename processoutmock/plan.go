@@ -0,0 +1,160 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// PlanService is a mock of processout.PlanService. Each method calls the
+// function of the same name. The methods without a context fall back to the
+// function of their WithContext variant, and the other way around, so that
+// only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type PlanService struct {
+	AllFunc                 func(options ...processout.PlanAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc      func(ctx context.Context, options ...processout.PlanAllParameters) (*processout.Iterator, error)
+	AllTypedFunc            func(options ...processout.PlanAllParameters) (*processout.TypedIterator[*processout.Plan], error)
+	AllTypedWithContextFunc func(ctx context.Context, options ...processout.PlanAllParameters) (*processout.TypedIterator[*processout.Plan], error)
+	CreateFunc              func(options ...processout.PlanCreateParameters) (*processout.Plan, error)
+	CreateWithContextFunc   func(ctx context.Context, options ...processout.PlanCreateParameters) (*processout.Plan, error)
+	FindFunc                func(planID string, options ...processout.PlanFindParameters) (*processout.Plan, error)
+	FindWithContextFunc     func(ctx context.Context, planID string, options ...processout.PlanFindParameters) (*processout.Plan, error)
+	SaveFunc                func(options ...processout.PlanSaveParameters) (*processout.Plan, error)
+	SaveWithContextFunc     func(ctx context.Context, options ...processout.PlanSaveParameters) (*processout.Plan, error)
+	EndFunc                 func(options ...processout.PlanEndParameters) error
+	EndWithContextFunc      func(ctx context.Context, options ...processout.PlanEndParameters) error
+}
+
+var _ processout.PlanService = &PlanService{}
+
+// All calls AllFunc
+func (m *PlanService) All(options ...processout.PlanAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *PlanService) AllWithContext(ctx context.Context, options ...processout.PlanAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: PlanService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *PlanService) AllTyped(options ...processout.PlanAllParameters) (*processout.TypedIterator[*processout.Plan], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *PlanService) AllTypedWithContext(ctx context.Context, options ...processout.PlanAllParameters) (*processout.TypedIterator[*processout.Plan], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Plan]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *PlanService) Create(options ...processout.PlanCreateParameters) (*processout.Plan, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *PlanService) CreateWithContext(ctx context.Context, options ...processout.PlanCreateParameters) (*processout.Plan, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: PlanService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *PlanService) Find(planID string, options ...processout.PlanFindParameters) (*processout.Plan, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(planID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), planID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *PlanService) FindWithContext(ctx context.Context, planID string, options ...processout.PlanFindParameters) (*processout.Plan, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, planID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(planID, options...)
+	}
+
+	panic("processoutmock: PlanService.Find is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *PlanService) Save(options ...processout.PlanSaveParameters) (*processout.Plan, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *PlanService) SaveWithContext(ctx context.Context, options ...processout.PlanSaveParameters) (*processout.Plan, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: PlanService.Save is not mocked")
+}
+
+// End calls EndFunc
+func (m *PlanService) End(options ...processout.PlanEndParameters) error {
+	if m.EndFunc != nil {
+		return m.EndFunc(options...)
+	}
+
+	return m.EndWithContext(context.Background(), options...)
+}
+
+// EndWithContext calls EndWithContextFunc
+func (m *PlanService) EndWithContext(ctx context.Context, options ...processout.PlanEndParameters) error {
+	if m.EndWithContextFunc != nil {
+		return m.EndWithContextFunc(ctx, options...)
+	}
+	if m.EndFunc != nil {
+		return m.EndFunc(options...)
+	}
+
+	panic("processoutmock: PlanService.End is not mocked")
+}
@@ -0,0 +1,80 @@
+package processoutmock
+
+import (
+	"context"
+	"testing"
+
+	"gopkg.in/processout.v4"
+)
+
+// capture is an example of code using the client through its interface
+func capture(ctx context.Context, c processout.Client, invoiceID string) (string, error) {
+	iv, err := c.Invoices().FindWithContext(ctx, invoiceID)
+	if err != nil {
+		return "", err
+	}
+	tr, err := c.Invoices(iv).CaptureWithContext(ctx, "tok_test")
+	if err != nil {
+		return "", err
+	}
+
+	return *tr.Status, nil
+}
+
+func TestMocks(t *testing.T) {
+	var captured string
+	c := &Client{
+		InvoicesFunc: func(prefill ...*processout.Invoice) processout.InvoiceService {
+			return &InvoiceService{
+				FindFunc: func(invoiceID string, options ...processout.InvoiceFindParameters) (*processout.Invoice, error) {
+					return &processout.Invoice{ID: &invoiceID}, nil
+				},
+				CaptureWithContextFunc: func(ctx context.Context, source string, options ...processout.InvoiceCaptureParameters) (*processout.Transaction, error) {
+					captured = *prefill[0].ID
+					return &processout.Transaction{Status: processout.String("completed")}, nil
+				},
+			}
+		},
+	}
+
+	status, err := capture(context.Background(), c, "iv_test")
+	if err != nil {
+		t.Fatalf("The invoice could not be captured: %s", err.Error())
+	}
+	if status != "completed" || captured != "iv_test" {
+		t.Errorf("The mocked invoice should have been captured, got %s for %s", status, captured)
+	}
+}
+
+func TestMockTypedFallback(t *testing.T) {
+	m := &CustomerService{
+		AllFunc: func(options ...processout.CustomerAllParameters) (*processout.Iterator, error) {
+			return processout.NewSliceIterator(
+				&processout.Customer{ID: processout.String("cust_1")},
+				&processout.Customer{ID: processout.String("cust_2")},
+			), nil
+		},
+	}
+
+	it, err := m.AllTypedWithContext(context.Background())
+	if err != nil {
+		t.Fatalf("The customers could not be listed: %s", err.Error())
+	}
+	ids := []string{}
+	for it.Next() {
+		ids = append(ids, *it.Current().ID)
+	}
+	if err := it.Error(); err != nil {
+		t.Fatalf("The customers could not be listed: %s", err.Error())
+	}
+	if len(ids) != 2 || ids[1] != "cust_2" {
+		t.Errorf("The mocked customers should have been listed, got %v", ids)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Calling a method that isn't mocked should panic")
+		}
+	}()
+	m.Find("cust_1")
+}
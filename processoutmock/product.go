@@ -0,0 +1,183 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// ProductService is a mock of processout.ProductService. Each method calls
+// the function of the same name. The methods without a context fall back to
+// the function of their WithContext variant, and the other way around, so
+// that only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type ProductService struct {
+	CreateInvoiceFunc            func(options ...processout.ProductCreateInvoiceParameters) (*processout.Invoice, error)
+	CreateInvoiceWithContextFunc func(ctx context.Context, options ...processout.ProductCreateInvoiceParameters) (*processout.Invoice, error)
+	AllFunc                      func(options ...processout.ProductAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc           func(ctx context.Context, options ...processout.ProductAllParameters) (*processout.Iterator, error)
+	AllTypedFunc                 func(options ...processout.ProductAllParameters) (*processout.TypedIterator[*processout.Product], error)
+	AllTypedWithContextFunc      func(ctx context.Context, options ...processout.ProductAllParameters) (*processout.TypedIterator[*processout.Product], error)
+	CreateFunc                   func(options ...processout.ProductCreateParameters) (*processout.Product, error)
+	CreateWithContextFunc        func(ctx context.Context, options ...processout.ProductCreateParameters) (*processout.Product, error)
+	FindFunc                     func(productID string, options ...processout.ProductFindParameters) (*processout.Product, error)
+	FindWithContextFunc          func(ctx context.Context, productID string, options ...processout.ProductFindParameters) (*processout.Product, error)
+	SaveFunc                     func(options ...processout.ProductSaveParameters) (*processout.Product, error)
+	SaveWithContextFunc          func(ctx context.Context, options ...processout.ProductSaveParameters) (*processout.Product, error)
+	DeleteFunc                   func(options ...processout.ProductDeleteParameters) error
+	DeleteWithContextFunc        func(ctx context.Context, options ...processout.ProductDeleteParameters) error
+}
+
+var _ processout.ProductService = &ProductService{}
+
+// CreateInvoice calls CreateInvoiceFunc
+func (m *ProductService) CreateInvoice(options ...processout.ProductCreateInvoiceParameters) (*processout.Invoice, error) {
+	if m.CreateInvoiceFunc != nil {
+		return m.CreateInvoiceFunc(options...)
+	}
+
+	return m.CreateInvoiceWithContext(context.Background(), options...)
+}
+
+// CreateInvoiceWithContext calls CreateInvoiceWithContextFunc
+func (m *ProductService) CreateInvoiceWithContext(ctx context.Context, options ...processout.ProductCreateInvoiceParameters) (*processout.Invoice, error) {
+	if m.CreateInvoiceWithContextFunc != nil {
+		return m.CreateInvoiceWithContextFunc(ctx, options...)
+	}
+	if m.CreateInvoiceFunc != nil {
+		return m.CreateInvoiceFunc(options...)
+	}
+
+	panic("processoutmock: ProductService.CreateInvoice is not mocked")
+}
+
+// All calls AllFunc
+func (m *ProductService) All(options ...processout.ProductAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *ProductService) AllWithContext(ctx context.Context, options ...processout.ProductAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: ProductService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *ProductService) AllTyped(options ...processout.ProductAllParameters) (*processout.TypedIterator[*processout.Product], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *ProductService) AllTypedWithContext(ctx context.Context, options ...processout.ProductAllParameters) (*processout.TypedIterator[*processout.Product], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Product]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *ProductService) Create(options ...processout.ProductCreateParameters) (*processout.Product, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *ProductService) CreateWithContext(ctx context.Context, options ...processout.ProductCreateParameters) (*processout.Product, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: ProductService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *ProductService) Find(productID string, options ...processout.ProductFindParameters) (*processout.Product, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(productID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), productID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *ProductService) FindWithContext(ctx context.Context, productID string, options ...processout.ProductFindParameters) (*processout.Product, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, productID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(productID, options...)
+	}
+
+	panic("processoutmock: ProductService.Find is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *ProductService) Save(options ...processout.ProductSaveParameters) (*processout.Product, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *ProductService) SaveWithContext(ctx context.Context, options ...processout.ProductSaveParameters) (*processout.Product, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: ProductService.Save is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *ProductService) Delete(options ...processout.ProductDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *ProductService) DeleteWithContext(ctx context.Context, options ...processout.ProductDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: ProductService.Delete is not mocked")
+}
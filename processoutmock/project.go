@@ -0,0 +1,160 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// ProjectService is a mock of processout.ProjectService. Each method calls
+// the function of the same name. The methods without a context fall back to
+// the function of their WithContext variant, and the other way around, so
+// that only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type ProjectService struct {
+	FetchFunc                           func(options ...processout.ProjectFetchParameters) (*processout.Project, error)
+	FetchWithContextFunc                func(ctx context.Context, options ...processout.ProjectFetchParameters) (*processout.Project, error)
+	SaveFunc                            func(options ...processout.ProjectSaveParameters) (*processout.Project, error)
+	SaveWithContextFunc                 func(ctx context.Context, options ...processout.ProjectSaveParameters) (*processout.Project, error)
+	DeleteFunc                          func(options ...processout.ProjectDeleteParameters) error
+	DeleteWithContextFunc               func(ctx context.Context, options ...processout.ProjectDeleteParameters) error
+	FetchSupervisedFunc                 func(options ...processout.ProjectFetchSupervisedParameters) (*processout.Iterator, error)
+	FetchSupervisedWithContextFunc      func(ctx context.Context, options ...processout.ProjectFetchSupervisedParameters) (*processout.Iterator, error)
+	FetchSupervisedTypedFunc            func(options ...processout.ProjectFetchSupervisedParameters) (*processout.TypedIterator[*processout.Project], error)
+	FetchSupervisedTypedWithContextFunc func(ctx context.Context, options ...processout.ProjectFetchSupervisedParameters) (*processout.TypedIterator[*processout.Project], error)
+	CreateSupervisedFunc                func(options ...processout.ProjectCreateSupervisedParameters) (*processout.Project, error)
+	CreateSupervisedWithContextFunc     func(ctx context.Context, options ...processout.ProjectCreateSupervisedParameters) (*processout.Project, error)
+}
+
+var _ processout.ProjectService = &ProjectService{}
+
+// Fetch calls FetchFunc
+func (m *ProjectService) Fetch(options ...processout.ProjectFetchParameters) (*processout.Project, error) {
+	if m.FetchFunc != nil {
+		return m.FetchFunc(options...)
+	}
+
+	return m.FetchWithContext(context.Background(), options...)
+}
+
+// FetchWithContext calls FetchWithContextFunc
+func (m *ProjectService) FetchWithContext(ctx context.Context, options ...processout.ProjectFetchParameters) (*processout.Project, error) {
+	if m.FetchWithContextFunc != nil {
+		return m.FetchWithContextFunc(ctx, options...)
+	}
+	if m.FetchFunc != nil {
+		return m.FetchFunc(options...)
+	}
+
+	panic("processoutmock: ProjectService.Fetch is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *ProjectService) Save(options ...processout.ProjectSaveParameters) (*processout.Project, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *ProjectService) SaveWithContext(ctx context.Context, options ...processout.ProjectSaveParameters) (*processout.Project, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: ProjectService.Save is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *ProjectService) Delete(options ...processout.ProjectDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *ProjectService) DeleteWithContext(ctx context.Context, options ...processout.ProjectDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: ProjectService.Delete is not mocked")
+}
+
+// FetchSupervised calls FetchSupervisedFunc
+func (m *ProjectService) FetchSupervised(options ...processout.ProjectFetchSupervisedParameters) (*processout.Iterator, error) {
+	if m.FetchSupervisedFunc != nil {
+		return m.FetchSupervisedFunc(options...)
+	}
+
+	return m.FetchSupervisedWithContext(context.Background(), options...)
+}
+
+// FetchSupervisedWithContext calls FetchSupervisedWithContextFunc
+func (m *ProjectService) FetchSupervisedWithContext(ctx context.Context, options ...processout.ProjectFetchSupervisedParameters) (*processout.Iterator, error) {
+	if m.FetchSupervisedWithContextFunc != nil {
+		return m.FetchSupervisedWithContextFunc(ctx, options...)
+	}
+	if m.FetchSupervisedFunc != nil {
+		return m.FetchSupervisedFunc(options...)
+	}
+
+	panic("processoutmock: ProjectService.FetchSupervised is not mocked")
+}
+
+// FetchSupervisedTyped calls FetchSupervisedTypedFunc
+func (m *ProjectService) FetchSupervisedTyped(options ...processout.ProjectFetchSupervisedParameters) (*processout.TypedIterator[*processout.Project], error) {
+	if m.FetchSupervisedTypedFunc != nil {
+		return m.FetchSupervisedTypedFunc(options...)
+	}
+
+	return m.FetchSupervisedTypedWithContext(context.Background(), options...)
+}
+
+// FetchSupervisedTypedWithContext calls FetchSupervisedTypedWithContextFunc
+func (m *ProjectService) FetchSupervisedTypedWithContext(ctx context.Context, options ...processout.ProjectFetchSupervisedParameters) (*processout.TypedIterator[*processout.Project], error) {
+	if m.FetchSupervisedTypedWithContextFunc != nil {
+		return m.FetchSupervisedTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchSupervisedTypedFunc != nil {
+		return m.FetchSupervisedTypedFunc(options...)
+	}
+
+	it, err := m.FetchSupervisedWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Project]{Iterator: it}, nil
+}
+
+// CreateSupervised calls CreateSupervisedFunc
+func (m *ProjectService) CreateSupervised(options ...processout.ProjectCreateSupervisedParameters) (*processout.Project, error) {
+	if m.CreateSupervisedFunc != nil {
+		return m.CreateSupervisedFunc(options...)
+	}
+
+	return m.CreateSupervisedWithContext(context.Background(), options...)
+}
+
+// CreateSupervisedWithContext calls CreateSupervisedWithContextFunc
+func (m *ProjectService) CreateSupervisedWithContext(ctx context.Context, options ...processout.ProjectCreateSupervisedParameters) (*processout.Project, error) {
+	if m.CreateSupervisedWithContextFunc != nil {
+		return m.CreateSupervisedWithContextFunc(ctx, options...)
+	}
+	if m.CreateSupervisedFunc != nil {
+		return m.CreateSupervisedFunc(options...)
+	}
+
+	panic("processoutmock: ProjectService.CreateSupervised is not mocked")
+}
@@ -0,0 +1,114 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// RefundService is a mock of processout.RefundService. Each method calls the
+// function of the same name. The methods without a context fall back to the
+// function of their WithContext variant, and the other way around, so that
+// only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type RefundService struct {
+	FetchTransactionRefundsFunc                 func(transactionID string, options ...processout.RefundFetchTransactionRefundsParameters) (*processout.Iterator, error)
+	FetchTransactionRefundsWithContextFunc      func(ctx context.Context, transactionID string, options ...processout.RefundFetchTransactionRefundsParameters) (*processout.Iterator, error)
+	FetchTransactionRefundsTypedFunc            func(transactionID string, options ...processout.RefundFetchTransactionRefundsParameters) (*processout.TypedIterator[*processout.Refund], error)
+	FetchTransactionRefundsTypedWithContextFunc func(ctx context.Context, transactionID string, options ...processout.RefundFetchTransactionRefundsParameters) (*processout.TypedIterator[*processout.Refund], error)
+	FindFunc                                    func(transactionID, refundID string, options ...processout.RefundFindParameters) (*processout.Refund, error)
+	FindWithContextFunc                         func(ctx context.Context, transactionID, refundID string, options ...processout.RefundFindParameters) (*processout.Refund, error)
+	CreateFunc                                  func(options ...processout.RefundCreateParameters) error
+	CreateWithContextFunc                       func(ctx context.Context, options ...processout.RefundCreateParameters) error
+}
+
+var _ processout.RefundService = &RefundService{}
+
+// FetchTransactionRefunds calls FetchTransactionRefundsFunc
+func (m *RefundService) FetchTransactionRefunds(transactionID string, options ...processout.RefundFetchTransactionRefundsParameters) (*processout.Iterator, error) {
+	if m.FetchTransactionRefundsFunc != nil {
+		return m.FetchTransactionRefundsFunc(transactionID, options...)
+	}
+
+	return m.FetchTransactionRefundsWithContext(context.Background(), transactionID, options...)
+}
+
+// FetchTransactionRefundsWithContext calls FetchTransactionRefundsWithContextFunc
+func (m *RefundService) FetchTransactionRefundsWithContext(ctx context.Context, transactionID string, options ...processout.RefundFetchTransactionRefundsParameters) (*processout.Iterator, error) {
+	if m.FetchTransactionRefundsWithContextFunc != nil {
+		return m.FetchTransactionRefundsWithContextFunc(ctx, transactionID, options...)
+	}
+	if m.FetchTransactionRefundsFunc != nil {
+		return m.FetchTransactionRefundsFunc(transactionID, options...)
+	}
+
+	panic("processoutmock: RefundService.FetchTransactionRefunds is not mocked")
+}
+
+// FetchTransactionRefundsTyped calls FetchTransactionRefundsTypedFunc
+func (m *RefundService) FetchTransactionRefundsTyped(transactionID string, options ...processout.RefundFetchTransactionRefundsParameters) (*processout.TypedIterator[*processout.Refund], error) {
+	if m.FetchTransactionRefundsTypedFunc != nil {
+		return m.FetchTransactionRefundsTypedFunc(transactionID, options...)
+	}
+
+	return m.FetchTransactionRefundsTypedWithContext(context.Background(), transactionID, options...)
+}
+
+// FetchTransactionRefundsTypedWithContext calls FetchTransactionRefundsTypedWithContextFunc
+func (m *RefundService) FetchTransactionRefundsTypedWithContext(ctx context.Context, transactionID string, options ...processout.RefundFetchTransactionRefundsParameters) (*processout.TypedIterator[*processout.Refund], error) {
+	if m.FetchTransactionRefundsTypedWithContextFunc != nil {
+		return m.FetchTransactionRefundsTypedWithContextFunc(ctx, transactionID, options...)
+	}
+	if m.FetchTransactionRefundsTypedFunc != nil {
+		return m.FetchTransactionRefundsTypedFunc(transactionID, options...)
+	}
+
+	it, err := m.FetchTransactionRefundsWithContext(ctx, transactionID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Refund]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *RefundService) Find(transactionID, refundID string, options ...processout.RefundFindParameters) (*processout.Refund, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(transactionID, refundID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), transactionID, refundID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *RefundService) FindWithContext(ctx context.Context, transactionID, refundID string, options ...processout.RefundFindParameters) (*processout.Refund, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, transactionID, refundID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(transactionID, refundID, options...)
+	}
+
+	panic("processoutmock: RefundService.Find is not mocked")
+}
+
+// Create calls CreateFunc
+func (m *RefundService) Create(options ...processout.RefundCreateParameters) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *RefundService) CreateWithContext(ctx context.Context, options ...processout.RefundCreateParameters) error {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: RefundService.Create is not mocked")
+}
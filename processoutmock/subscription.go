@@ -0,0 +1,425 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// SubscriptionService is a mock of processout.SubscriptionService. Each
+// method calls the function of the same name. The methods without a context
+// fall back to the function of their WithContext variant, and the other way
+// around, so that only one of them needs to be set. The Typed variants fall
+// back to the functions of the untyped ones. Calling a method whose function
+// isn't set panics
+type SubscriptionService struct {
+	FetchAddonsFunc                       func(options ...processout.SubscriptionFetchAddonsParameters) (*processout.Iterator, error)
+	FetchAddonsWithContextFunc            func(ctx context.Context, options ...processout.SubscriptionFetchAddonsParameters) (*processout.Iterator, error)
+	FetchAddonsTypedFunc                  func(options ...processout.SubscriptionFetchAddonsParameters) (*processout.TypedIterator[*processout.Addon], error)
+	FetchAddonsTypedWithContextFunc       func(ctx context.Context, options ...processout.SubscriptionFetchAddonsParameters) (*processout.TypedIterator[*processout.Addon], error)
+	FindAddonFunc                         func(addonID string, options ...processout.SubscriptionFindAddonParameters) (*processout.Addon, error)
+	FindAddonWithContextFunc              func(ctx context.Context, addonID string, options ...processout.SubscriptionFindAddonParameters) (*processout.Addon, error)
+	DeleteAddonFunc                       func(addonID string, options ...processout.SubscriptionDeleteAddonParameters) error
+	DeleteAddonWithContextFunc            func(ctx context.Context, addonID string, options ...processout.SubscriptionDeleteAddonParameters) error
+	FetchCustomerFunc                     func(options ...processout.SubscriptionFetchCustomerParameters) (*processout.Customer, error)
+	FetchCustomerWithContextFunc          func(ctx context.Context, options ...processout.SubscriptionFetchCustomerParameters) (*processout.Customer, error)
+	FetchDiscountsFunc                    func(options ...processout.SubscriptionFetchDiscountsParameters) (*processout.Iterator, error)
+	FetchDiscountsWithContextFunc         func(ctx context.Context, options ...processout.SubscriptionFetchDiscountsParameters) (*processout.Iterator, error)
+	FetchDiscountsTypedFunc               func(options ...processout.SubscriptionFetchDiscountsParameters) (*processout.TypedIterator[*processout.Discount], error)
+	FetchDiscountsTypedWithContextFunc    func(ctx context.Context, options ...processout.SubscriptionFetchDiscountsParameters) (*processout.TypedIterator[*processout.Discount], error)
+	FindDiscountFunc                      func(discountID string, options ...processout.SubscriptionFindDiscountParameters) (*processout.Discount, error)
+	FindDiscountWithContextFunc           func(ctx context.Context, discountID string, options ...processout.SubscriptionFindDiscountParameters) (*processout.Discount, error)
+	DeleteDiscountFunc                    func(discountID string, options ...processout.SubscriptionDeleteDiscountParameters) error
+	DeleteDiscountWithContextFunc         func(ctx context.Context, discountID string, options ...processout.SubscriptionDeleteDiscountParameters) error
+	FetchTransactionsFunc                 func(options ...processout.SubscriptionFetchTransactionsParameters) (*processout.Iterator, error)
+	FetchTransactionsWithContextFunc      func(ctx context.Context, options ...processout.SubscriptionFetchTransactionsParameters) (*processout.Iterator, error)
+	FetchTransactionsTypedFunc            func(options ...processout.SubscriptionFetchTransactionsParameters) (*processout.TypedIterator[*processout.Transaction], error)
+	FetchTransactionsTypedWithContextFunc func(ctx context.Context, options ...processout.SubscriptionFetchTransactionsParameters) (*processout.TypedIterator[*processout.Transaction], error)
+	AllFunc                               func(options ...processout.SubscriptionAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc                    func(ctx context.Context, options ...processout.SubscriptionAllParameters) (*processout.Iterator, error)
+	AllTypedFunc                          func(options ...processout.SubscriptionAllParameters) (*processout.TypedIterator[*processout.Subscription], error)
+	AllTypedWithContextFunc               func(ctx context.Context, options ...processout.SubscriptionAllParameters) (*processout.TypedIterator[*processout.Subscription], error)
+	CreateFunc                            func(options ...processout.SubscriptionCreateParameters) (*processout.Subscription, error)
+	CreateWithContextFunc                 func(ctx context.Context, options ...processout.SubscriptionCreateParameters) (*processout.Subscription, error)
+	FindFunc                              func(subscriptionID string, options ...processout.SubscriptionFindParameters) (*processout.Subscription, error)
+	FindWithContextFunc                   func(ctx context.Context, subscriptionID string, options ...processout.SubscriptionFindParameters) (*processout.Subscription, error)
+	SaveFunc                              func(options ...processout.SubscriptionSaveParameters) (*processout.Subscription, error)
+	SaveWithContextFunc                   func(ctx context.Context, options ...processout.SubscriptionSaveParameters) (*processout.Subscription, error)
+	CancelFunc                            func(options ...processout.SubscriptionCancelParameters) (*processout.Subscription, error)
+	CancelWithContextFunc                 func(ctx context.Context, options ...processout.SubscriptionCancelParameters) (*processout.Subscription, error)
+}
+
+var _ processout.SubscriptionService = &SubscriptionService{}
+
+// FetchAddons calls FetchAddonsFunc
+func (m *SubscriptionService) FetchAddons(options ...processout.SubscriptionFetchAddonsParameters) (*processout.Iterator, error) {
+	if m.FetchAddonsFunc != nil {
+		return m.FetchAddonsFunc(options...)
+	}
+
+	return m.FetchAddonsWithContext(context.Background(), options...)
+}
+
+// FetchAddonsWithContext calls FetchAddonsWithContextFunc
+func (m *SubscriptionService) FetchAddonsWithContext(ctx context.Context, options ...processout.SubscriptionFetchAddonsParameters) (*processout.Iterator, error) {
+	if m.FetchAddonsWithContextFunc != nil {
+		return m.FetchAddonsWithContextFunc(ctx, options...)
+	}
+	if m.FetchAddonsFunc != nil {
+		return m.FetchAddonsFunc(options...)
+	}
+
+	panic("processoutmock: SubscriptionService.FetchAddons is not mocked")
+}
+
+// FetchAddonsTyped calls FetchAddonsTypedFunc
+func (m *SubscriptionService) FetchAddonsTyped(options ...processout.SubscriptionFetchAddonsParameters) (*processout.TypedIterator[*processout.Addon], error) {
+	if m.FetchAddonsTypedFunc != nil {
+		return m.FetchAddonsTypedFunc(options...)
+	}
+
+	return m.FetchAddonsTypedWithContext(context.Background(), options...)
+}
+
+// FetchAddonsTypedWithContext calls FetchAddonsTypedWithContextFunc
+func (m *SubscriptionService) FetchAddonsTypedWithContext(ctx context.Context, options ...processout.SubscriptionFetchAddonsParameters) (*processout.TypedIterator[*processout.Addon], error) {
+	if m.FetchAddonsTypedWithContextFunc != nil {
+		return m.FetchAddonsTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchAddonsTypedFunc != nil {
+		return m.FetchAddonsTypedFunc(options...)
+	}
+
+	it, err := m.FetchAddonsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Addon]{Iterator: it}, nil
+}
+
+// FindAddon calls FindAddonFunc
+func (m *SubscriptionService) FindAddon(addonID string, options ...processout.SubscriptionFindAddonParameters) (*processout.Addon, error) {
+	if m.FindAddonFunc != nil {
+		return m.FindAddonFunc(addonID, options...)
+	}
+
+	return m.FindAddonWithContext(context.Background(), addonID, options...)
+}
+
+// FindAddonWithContext calls FindAddonWithContextFunc
+func (m *SubscriptionService) FindAddonWithContext(ctx context.Context, addonID string, options ...processout.SubscriptionFindAddonParameters) (*processout.Addon, error) {
+	if m.FindAddonWithContextFunc != nil {
+		return m.FindAddonWithContextFunc(ctx, addonID, options...)
+	}
+	if m.FindAddonFunc != nil {
+		return m.FindAddonFunc(addonID, options...)
+	}
+
+	panic("processoutmock: SubscriptionService.FindAddon is not mocked")
+}
+
+// DeleteAddon calls DeleteAddonFunc
+func (m *SubscriptionService) DeleteAddon(addonID string, options ...processout.SubscriptionDeleteAddonParameters) error {
+	if m.DeleteAddonFunc != nil {
+		return m.DeleteAddonFunc(addonID, options...)
+	}
+
+	return m.DeleteAddonWithContext(context.Background(), addonID, options...)
+}
+
+// DeleteAddonWithContext calls DeleteAddonWithContextFunc
+func (m *SubscriptionService) DeleteAddonWithContext(ctx context.Context, addonID string, options ...processout.SubscriptionDeleteAddonParameters) error {
+	if m.DeleteAddonWithContextFunc != nil {
+		return m.DeleteAddonWithContextFunc(ctx, addonID, options...)
+	}
+	if m.DeleteAddonFunc != nil {
+		return m.DeleteAddonFunc(addonID, options...)
+	}
+
+	panic("processoutmock: SubscriptionService.DeleteAddon is not mocked")
+}
+
+// FetchCustomer calls FetchCustomerFunc
+func (m *SubscriptionService) FetchCustomer(options ...processout.SubscriptionFetchCustomerParameters) (*processout.Customer, error) {
+	if m.FetchCustomerFunc != nil {
+		return m.FetchCustomerFunc(options...)
+	}
+
+	return m.FetchCustomerWithContext(context.Background(), options...)
+}
+
+// FetchCustomerWithContext calls FetchCustomerWithContextFunc
+func (m *SubscriptionService) FetchCustomerWithContext(ctx context.Context, options ...processout.SubscriptionFetchCustomerParameters) (*processout.Customer, error) {
+	if m.FetchCustomerWithContextFunc != nil {
+		return m.FetchCustomerWithContextFunc(ctx, options...)
+	}
+	if m.FetchCustomerFunc != nil {
+		return m.FetchCustomerFunc(options...)
+	}
+
+	panic("processoutmock: SubscriptionService.FetchCustomer is not mocked")
+}
+
+// FetchDiscounts calls FetchDiscountsFunc
+func (m *SubscriptionService) FetchDiscounts(options ...processout.SubscriptionFetchDiscountsParameters) (*processout.Iterator, error) {
+	if m.FetchDiscountsFunc != nil {
+		return m.FetchDiscountsFunc(options...)
+	}
+
+	return m.FetchDiscountsWithContext(context.Background(), options...)
+}
+
+// FetchDiscountsWithContext calls FetchDiscountsWithContextFunc
+func (m *SubscriptionService) FetchDiscountsWithContext(ctx context.Context, options ...processout.SubscriptionFetchDiscountsParameters) (*processout.Iterator, error) {
+	if m.FetchDiscountsWithContextFunc != nil {
+		return m.FetchDiscountsWithContextFunc(ctx, options...)
+	}
+	if m.FetchDiscountsFunc != nil {
+		return m.FetchDiscountsFunc(options...)
+	}
+
+	panic("processoutmock: SubscriptionService.FetchDiscounts is not mocked")
+}
+
+// FetchDiscountsTyped calls FetchDiscountsTypedFunc
+func (m *SubscriptionService) FetchDiscountsTyped(options ...processout.SubscriptionFetchDiscountsParameters) (*processout.TypedIterator[*processout.Discount], error) {
+	if m.FetchDiscountsTypedFunc != nil {
+		return m.FetchDiscountsTypedFunc(options...)
+	}
+
+	return m.FetchDiscountsTypedWithContext(context.Background(), options...)
+}
+
+// FetchDiscountsTypedWithContext calls FetchDiscountsTypedWithContextFunc
+func (m *SubscriptionService) FetchDiscountsTypedWithContext(ctx context.Context, options ...processout.SubscriptionFetchDiscountsParameters) (*processout.TypedIterator[*processout.Discount], error) {
+	if m.FetchDiscountsTypedWithContextFunc != nil {
+		return m.FetchDiscountsTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchDiscountsTypedFunc != nil {
+		return m.FetchDiscountsTypedFunc(options...)
+	}
+
+	it, err := m.FetchDiscountsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Discount]{Iterator: it}, nil
+}
+
+// FindDiscount calls FindDiscountFunc
+func (m *SubscriptionService) FindDiscount(discountID string, options ...processout.SubscriptionFindDiscountParameters) (*processout.Discount, error) {
+	if m.FindDiscountFunc != nil {
+		return m.FindDiscountFunc(discountID, options...)
+	}
+
+	return m.FindDiscountWithContext(context.Background(), discountID, options...)
+}
+
+// FindDiscountWithContext calls FindDiscountWithContextFunc
+func (m *SubscriptionService) FindDiscountWithContext(ctx context.Context, discountID string, options ...processout.SubscriptionFindDiscountParameters) (*processout.Discount, error) {
+	if m.FindDiscountWithContextFunc != nil {
+		return m.FindDiscountWithContextFunc(ctx, discountID, options...)
+	}
+	if m.FindDiscountFunc != nil {
+		return m.FindDiscountFunc(discountID, options...)
+	}
+
+	panic("processoutmock: SubscriptionService.FindDiscount is not mocked")
+}
+
+// DeleteDiscount calls DeleteDiscountFunc
+func (m *SubscriptionService) DeleteDiscount(discountID string, options ...processout.SubscriptionDeleteDiscountParameters) error {
+	if m.DeleteDiscountFunc != nil {
+		return m.DeleteDiscountFunc(discountID, options...)
+	}
+
+	return m.DeleteDiscountWithContext(context.Background(), discountID, options...)
+}
+
+// DeleteDiscountWithContext calls DeleteDiscountWithContextFunc
+func (m *SubscriptionService) DeleteDiscountWithContext(ctx context.Context, discountID string, options ...processout.SubscriptionDeleteDiscountParameters) error {
+	if m.DeleteDiscountWithContextFunc != nil {
+		return m.DeleteDiscountWithContextFunc(ctx, discountID, options...)
+	}
+	if m.DeleteDiscountFunc != nil {
+		return m.DeleteDiscountFunc(discountID, options...)
+	}
+
+	panic("processoutmock: SubscriptionService.DeleteDiscount is not mocked")
+}
+
+// FetchTransactions calls FetchTransactionsFunc
+func (m *SubscriptionService) FetchTransactions(options ...processout.SubscriptionFetchTransactionsParameters) (*processout.Iterator, error) {
+	if m.FetchTransactionsFunc != nil {
+		return m.FetchTransactionsFunc(options...)
+	}
+
+	return m.FetchTransactionsWithContext(context.Background(), options...)
+}
+
+// FetchTransactionsWithContext calls FetchTransactionsWithContextFunc
+func (m *SubscriptionService) FetchTransactionsWithContext(ctx context.Context, options ...processout.SubscriptionFetchTransactionsParameters) (*processout.Iterator, error) {
+	if m.FetchTransactionsWithContextFunc != nil {
+		return m.FetchTransactionsWithContextFunc(ctx, options...)
+	}
+	if m.FetchTransactionsFunc != nil {
+		return m.FetchTransactionsFunc(options...)
+	}
+
+	panic("processoutmock: SubscriptionService.FetchTransactions is not mocked")
+}
+
+// FetchTransactionsTyped calls FetchTransactionsTypedFunc
+func (m *SubscriptionService) FetchTransactionsTyped(options ...processout.SubscriptionFetchTransactionsParameters) (*processout.TypedIterator[*processout.Transaction], error) {
+	if m.FetchTransactionsTypedFunc != nil {
+		return m.FetchTransactionsTypedFunc(options...)
+	}
+
+	return m.FetchTransactionsTypedWithContext(context.Background(), options...)
+}
+
+// FetchTransactionsTypedWithContext calls FetchTransactionsTypedWithContextFunc
+func (m *SubscriptionService) FetchTransactionsTypedWithContext(ctx context.Context, options ...processout.SubscriptionFetchTransactionsParameters) (*processout.TypedIterator[*processout.Transaction], error) {
+	if m.FetchTransactionsTypedWithContextFunc != nil {
+		return m.FetchTransactionsTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchTransactionsTypedFunc != nil {
+		return m.FetchTransactionsTypedFunc(options...)
+	}
+
+	it, err := m.FetchTransactionsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Transaction]{Iterator: it}, nil
+}
+
+// All calls AllFunc
+func (m *SubscriptionService) All(options ...processout.SubscriptionAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *SubscriptionService) AllWithContext(ctx context.Context, options ...processout.SubscriptionAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: SubscriptionService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *SubscriptionService) AllTyped(options ...processout.SubscriptionAllParameters) (*processout.TypedIterator[*processout.Subscription], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *SubscriptionService) AllTypedWithContext(ctx context.Context, options ...processout.SubscriptionAllParameters) (*processout.TypedIterator[*processout.Subscription], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Subscription]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *SubscriptionService) Create(options ...processout.SubscriptionCreateParameters) (*processout.Subscription, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *SubscriptionService) CreateWithContext(ctx context.Context, options ...processout.SubscriptionCreateParameters) (*processout.Subscription, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: SubscriptionService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *SubscriptionService) Find(subscriptionID string, options ...processout.SubscriptionFindParameters) (*processout.Subscription, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(subscriptionID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *SubscriptionService) FindWithContext(ctx context.Context, subscriptionID string, options ...processout.SubscriptionFindParameters) (*processout.Subscription, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, subscriptionID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(subscriptionID, options...)
+	}
+
+	panic("processoutmock: SubscriptionService.Find is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *SubscriptionService) Save(options ...processout.SubscriptionSaveParameters) (*processout.Subscription, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *SubscriptionService) SaveWithContext(ctx context.Context, options ...processout.SubscriptionSaveParameters) (*processout.Subscription, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: SubscriptionService.Save is not mocked")
+}
+
+// Cancel calls CancelFunc
+func (m *SubscriptionService) Cancel(options ...processout.SubscriptionCancelParameters) (*processout.Subscription, error) {
+	if m.CancelFunc != nil {
+		return m.CancelFunc(options...)
+	}
+
+	return m.CancelWithContext(context.Background(), options...)
+}
+
+// CancelWithContext calls CancelWithContextFunc
+func (m *SubscriptionService) CancelWithContext(ctx context.Context, options ...processout.SubscriptionCancelParameters) (*processout.Subscription, error) {
+	if m.CancelWithContextFunc != nil {
+		return m.CancelWithContextFunc(ctx, options...)
+	}
+	if m.CancelFunc != nil {
+		return m.CancelFunc(options...)
+	}
+
+	panic("processoutmock: SubscriptionService.Cancel is not mocked")
+}
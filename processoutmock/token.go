@@ -0,0 +1,160 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// TokenService is a mock of processout.TokenService. Each method calls the
+// function of the same name. The methods without a context fall back to the
+// function of their WithContext variant, and the other way around, so that
+// only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type TokenService struct {
+	FetchCustomerTokensFunc                 func(customerID string, options ...processout.TokenFetchCustomerTokensParameters) (*processout.Iterator, error)
+	FetchCustomerTokensWithContextFunc      func(ctx context.Context, customerID string, options ...processout.TokenFetchCustomerTokensParameters) (*processout.Iterator, error)
+	FetchCustomerTokensTypedFunc            func(customerID string, options ...processout.TokenFetchCustomerTokensParameters) (*processout.TypedIterator[*processout.Token], error)
+	FetchCustomerTokensTypedWithContextFunc func(ctx context.Context, customerID string, options ...processout.TokenFetchCustomerTokensParameters) (*processout.TypedIterator[*processout.Token], error)
+	FindFunc                                func(customerID, tokenID string, options ...processout.TokenFindParameters) (*processout.Token, error)
+	FindWithContextFunc                     func(ctx context.Context, customerID, tokenID string, options ...processout.TokenFindParameters) (*processout.Token, error)
+	CreateFunc                              func(options ...processout.TokenCreateParameters) (*processout.Token, error)
+	CreateWithContextFunc                   func(ctx context.Context, options ...processout.TokenCreateParameters) (*processout.Token, error)
+	SaveFunc                                func(options ...processout.TokenSaveParameters) error
+	SaveWithContextFunc                     func(ctx context.Context, options ...processout.TokenSaveParameters) error
+	DeleteFunc                              func(options ...processout.TokenDeleteParameters) error
+	DeleteWithContextFunc                   func(ctx context.Context, options ...processout.TokenDeleteParameters) error
+}
+
+var _ processout.TokenService = &TokenService{}
+
+// FetchCustomerTokens calls FetchCustomerTokensFunc
+func (m *TokenService) FetchCustomerTokens(customerID string, options ...processout.TokenFetchCustomerTokensParameters) (*processout.Iterator, error) {
+	if m.FetchCustomerTokensFunc != nil {
+		return m.FetchCustomerTokensFunc(customerID, options...)
+	}
+
+	return m.FetchCustomerTokensWithContext(context.Background(), customerID, options...)
+}
+
+// FetchCustomerTokensWithContext calls FetchCustomerTokensWithContextFunc
+func (m *TokenService) FetchCustomerTokensWithContext(ctx context.Context, customerID string, options ...processout.TokenFetchCustomerTokensParameters) (*processout.Iterator, error) {
+	if m.FetchCustomerTokensWithContextFunc != nil {
+		return m.FetchCustomerTokensWithContextFunc(ctx, customerID, options...)
+	}
+	if m.FetchCustomerTokensFunc != nil {
+		return m.FetchCustomerTokensFunc(customerID, options...)
+	}
+
+	panic("processoutmock: TokenService.FetchCustomerTokens is not mocked")
+}
+
+// FetchCustomerTokensTyped calls FetchCustomerTokensTypedFunc
+func (m *TokenService) FetchCustomerTokensTyped(customerID string, options ...processout.TokenFetchCustomerTokensParameters) (*processout.TypedIterator[*processout.Token], error) {
+	if m.FetchCustomerTokensTypedFunc != nil {
+		return m.FetchCustomerTokensTypedFunc(customerID, options...)
+	}
+
+	return m.FetchCustomerTokensTypedWithContext(context.Background(), customerID, options...)
+}
+
+// FetchCustomerTokensTypedWithContext calls FetchCustomerTokensTypedWithContextFunc
+func (m *TokenService) FetchCustomerTokensTypedWithContext(ctx context.Context, customerID string, options ...processout.TokenFetchCustomerTokensParameters) (*processout.TypedIterator[*processout.Token], error) {
+	if m.FetchCustomerTokensTypedWithContextFunc != nil {
+		return m.FetchCustomerTokensTypedWithContextFunc(ctx, customerID, options...)
+	}
+	if m.FetchCustomerTokensTypedFunc != nil {
+		return m.FetchCustomerTokensTypedFunc(customerID, options...)
+	}
+
+	it, err := m.FetchCustomerTokensWithContext(ctx, customerID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Token]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *TokenService) Find(customerID, tokenID string, options ...processout.TokenFindParameters) (*processout.Token, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(customerID, tokenID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), customerID, tokenID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *TokenService) FindWithContext(ctx context.Context, customerID, tokenID string, options ...processout.TokenFindParameters) (*processout.Token, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, customerID, tokenID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(customerID, tokenID, options...)
+	}
+
+	panic("processoutmock: TokenService.Find is not mocked")
+}
+
+// Create calls CreateFunc
+func (m *TokenService) Create(options ...processout.TokenCreateParameters) (*processout.Token, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *TokenService) CreateWithContext(ctx context.Context, options ...processout.TokenCreateParameters) (*processout.Token, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: TokenService.Create is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *TokenService) Save(options ...processout.TokenSaveParameters) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *TokenService) SaveWithContext(ctx context.Context, options ...processout.TokenSaveParameters) error {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: TokenService.Save is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *TokenService) Delete(options ...processout.TokenDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *TokenService) DeleteWithContext(ctx context.Context, options ...processout.TokenDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: TokenService.Delete is not mocked")
+}
@@ -0,0 +1,164 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// TransactionService is a mock of processout.TransactionService. Each method
+// calls the function of the same name. The methods without a context fall
+// back to the function of their WithContext variant, and the other way
+// around, so that only one of them needs to be set. The Typed variants fall
+// back to the functions of the untyped ones. Calling a method whose function
+// isn't set panics
+type TransactionService struct {
+	FetchRefundsFunc                 func(options ...processout.TransactionFetchRefundsParameters) (*processout.Iterator, error)
+	FetchRefundsWithContextFunc      func(ctx context.Context, options ...processout.TransactionFetchRefundsParameters) (*processout.Iterator, error)
+	FetchRefundsTypedFunc            func(options ...processout.TransactionFetchRefundsParameters) (*processout.TypedIterator[*processout.Refund], error)
+	FetchRefundsTypedWithContextFunc func(ctx context.Context, options ...processout.TransactionFetchRefundsParameters) (*processout.TypedIterator[*processout.Refund], error)
+	FindRefundFunc                   func(refundID string, options ...processout.TransactionFindRefundParameters) (*processout.Refund, error)
+	FindRefundWithContextFunc        func(ctx context.Context, refundID string, options ...processout.TransactionFindRefundParameters) (*processout.Refund, error)
+	AllFunc                          func(options ...processout.TransactionAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc               func(ctx context.Context, options ...processout.TransactionAllParameters) (*processout.Iterator, error)
+	AllTypedFunc                     func(options ...processout.TransactionAllParameters) (*processout.TypedIterator[*processout.Transaction], error)
+	AllTypedWithContextFunc          func(ctx context.Context, options ...processout.TransactionAllParameters) (*processout.TypedIterator[*processout.Transaction], error)
+	FindFunc                         func(transactionID string, options ...processout.TransactionFindParameters) (*processout.Transaction, error)
+	FindWithContextFunc              func(ctx context.Context, transactionID string, options ...processout.TransactionFindParameters) (*processout.Transaction, error)
+}
+
+var _ processout.TransactionService = &TransactionService{}
+
+// FetchRefunds calls FetchRefundsFunc
+func (m *TransactionService) FetchRefunds(options ...processout.TransactionFetchRefundsParameters) (*processout.Iterator, error) {
+	if m.FetchRefundsFunc != nil {
+		return m.FetchRefundsFunc(options...)
+	}
+
+	return m.FetchRefundsWithContext(context.Background(), options...)
+}
+
+// FetchRefundsWithContext calls FetchRefundsWithContextFunc
+func (m *TransactionService) FetchRefundsWithContext(ctx context.Context, options ...processout.TransactionFetchRefundsParameters) (*processout.Iterator, error) {
+	if m.FetchRefundsWithContextFunc != nil {
+		return m.FetchRefundsWithContextFunc(ctx, options...)
+	}
+	if m.FetchRefundsFunc != nil {
+		return m.FetchRefundsFunc(options...)
+	}
+
+	panic("processoutmock: TransactionService.FetchRefunds is not mocked")
+}
+
+// FetchRefundsTyped calls FetchRefundsTypedFunc
+func (m *TransactionService) FetchRefundsTyped(options ...processout.TransactionFetchRefundsParameters) (*processout.TypedIterator[*processout.Refund], error) {
+	if m.FetchRefundsTypedFunc != nil {
+		return m.FetchRefundsTypedFunc(options...)
+	}
+
+	return m.FetchRefundsTypedWithContext(context.Background(), options...)
+}
+
+// FetchRefundsTypedWithContext calls FetchRefundsTypedWithContextFunc
+func (m *TransactionService) FetchRefundsTypedWithContext(ctx context.Context, options ...processout.TransactionFetchRefundsParameters) (*processout.TypedIterator[*processout.Refund], error) {
+	if m.FetchRefundsTypedWithContextFunc != nil {
+		return m.FetchRefundsTypedWithContextFunc(ctx, options...)
+	}
+	if m.FetchRefundsTypedFunc != nil {
+		return m.FetchRefundsTypedFunc(options...)
+	}
+
+	it, err := m.FetchRefundsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Refund]{Iterator: it}, nil
+}
+
+// FindRefund calls FindRefundFunc
+func (m *TransactionService) FindRefund(refundID string, options ...processout.TransactionFindRefundParameters) (*processout.Refund, error) {
+	if m.FindRefundFunc != nil {
+		return m.FindRefundFunc(refundID, options...)
+	}
+
+	return m.FindRefundWithContext(context.Background(), refundID, options...)
+}
+
+// FindRefundWithContext calls FindRefundWithContextFunc
+func (m *TransactionService) FindRefundWithContext(ctx context.Context, refundID string, options ...processout.TransactionFindRefundParameters) (*processout.Refund, error) {
+	if m.FindRefundWithContextFunc != nil {
+		return m.FindRefundWithContextFunc(ctx, refundID, options...)
+	}
+	if m.FindRefundFunc != nil {
+		return m.FindRefundFunc(refundID, options...)
+	}
+
+	panic("processoutmock: TransactionService.FindRefund is not mocked")
+}
+
+// All calls AllFunc
+func (m *TransactionService) All(options ...processout.TransactionAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *TransactionService) AllWithContext(ctx context.Context, options ...processout.TransactionAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: TransactionService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *TransactionService) AllTyped(options ...processout.TransactionAllParameters) (*processout.TypedIterator[*processout.Transaction], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *TransactionService) AllTypedWithContext(ctx context.Context, options ...processout.TransactionAllParameters) (*processout.TypedIterator[*processout.Transaction], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Transaction]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *TransactionService) Find(transactionID string, options ...processout.TransactionFindParameters) (*processout.Transaction, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(transactionID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), transactionID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *TransactionService) FindWithContext(ctx context.Context, transactionID string, options ...processout.TransactionFindParameters) (*processout.Transaction, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, transactionID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(transactionID, options...)
+	}
+
+	panic("processoutmock: TransactionService.Find is not mocked")
+}
@@ -0,0 +1,91 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// WebhookService is a mock of processout.WebhookService. Each method calls
+// the function of the same name. The methods without a context fall back to
+// the function of their WithContext variant, and the other way around, so
+// that only one of them needs to be set. The Typed variants fall back to the
+// functions of the untyped ones. Calling a method whose function isn't set
+// panics
+type WebhookService struct {
+	AllFunc                 func(options ...processout.WebhookAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc      func(ctx context.Context, options ...processout.WebhookAllParameters) (*processout.Iterator, error)
+	AllTypedFunc            func(options ...processout.WebhookAllParameters) (*processout.TypedIterator[*processout.Webhook], error)
+	AllTypedWithContextFunc func(ctx context.Context, options ...processout.WebhookAllParameters) (*processout.TypedIterator[*processout.Webhook], error)
+	FindFunc                func(webhookID string, options ...processout.WebhookFindParameters) (*processout.Webhook, error)
+	FindWithContextFunc     func(ctx context.Context, webhookID string, options ...processout.WebhookFindParameters) (*processout.Webhook, error)
+}
+
+var _ processout.WebhookService = &WebhookService{}
+
+// All calls AllFunc
+func (m *WebhookService) All(options ...processout.WebhookAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *WebhookService) AllWithContext(ctx context.Context, options ...processout.WebhookAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: WebhookService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *WebhookService) AllTyped(options ...processout.WebhookAllParameters) (*processout.TypedIterator[*processout.Webhook], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *WebhookService) AllTypedWithContext(ctx context.Context, options ...processout.WebhookAllParameters) (*processout.TypedIterator[*processout.Webhook], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.Webhook]{Iterator: it}, nil
+}
+
+// Find calls FindFunc
+func (m *WebhookService) Find(webhookID string, options ...processout.WebhookFindParameters) (*processout.Webhook, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(webhookID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), webhookID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *WebhookService) FindWithContext(ctx context.Context, webhookID string, options ...processout.WebhookFindParameters) (*processout.Webhook, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, webhookID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(webhookID, options...)
+	}
+
+	panic("processoutmock: WebhookService.Find is not mocked")
+}
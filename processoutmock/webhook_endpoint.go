@@ -0,0 +1,160 @@
+package processoutmock
+
+import (
+	"context"
+
+	"gopkg.in/processout.v4"
+)
+
+// WebhookEndpointService is a mock of processout.WebhookEndpointService.
+// Each method calls the function of the same name. The methods without a
+// context fall back to the function of their WithContext variant, and the
+// other way around, so that only one of them needs to be set. The Typed
+// variants fall back to the functions of the untyped ones. Calling a method
+// whose function isn't set panics
+type WebhookEndpointService struct {
+	AllFunc                 func(options ...processout.WebhookEndpointAllParameters) (*processout.Iterator, error)
+	AllWithContextFunc      func(ctx context.Context, options ...processout.WebhookEndpointAllParameters) (*processout.Iterator, error)
+	AllTypedFunc            func(options ...processout.WebhookEndpointAllParameters) (*processout.TypedIterator[*processout.WebhookEndpoint], error)
+	AllTypedWithContextFunc func(ctx context.Context, options ...processout.WebhookEndpointAllParameters) (*processout.TypedIterator[*processout.WebhookEndpoint], error)
+	CreateFunc              func(options ...processout.WebhookEndpointCreateParameters) (*processout.WebhookEndpoint, error)
+	CreateWithContextFunc   func(ctx context.Context, options ...processout.WebhookEndpointCreateParameters) (*processout.WebhookEndpoint, error)
+	FindFunc                func(webhookEndpointID string, options ...processout.WebhookEndpointFindParameters) (*processout.WebhookEndpoint, error)
+	FindWithContextFunc     func(ctx context.Context, webhookEndpointID string, options ...processout.WebhookEndpointFindParameters) (*processout.WebhookEndpoint, error)
+	SaveFunc                func(options ...processout.WebhookEndpointSaveParameters) (*processout.WebhookEndpoint, error)
+	SaveWithContextFunc     func(ctx context.Context, options ...processout.WebhookEndpointSaveParameters) (*processout.WebhookEndpoint, error)
+	DeleteFunc              func(options ...processout.WebhookEndpointDeleteParameters) error
+	DeleteWithContextFunc   func(ctx context.Context, options ...processout.WebhookEndpointDeleteParameters) error
+}
+
+var _ processout.WebhookEndpointService = &WebhookEndpointService{}
+
+// All calls AllFunc
+func (m *WebhookEndpointService) All(options ...processout.WebhookEndpointAllParameters) (*processout.Iterator, error) {
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	return m.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext calls AllWithContextFunc
+func (m *WebhookEndpointService) AllWithContext(ctx context.Context, options ...processout.WebhookEndpointAllParameters) (*processout.Iterator, error) {
+	if m.AllWithContextFunc != nil {
+		return m.AllWithContextFunc(ctx, options...)
+	}
+	if m.AllFunc != nil {
+		return m.AllFunc(options...)
+	}
+
+	panic("processoutmock: WebhookEndpointService.All is not mocked")
+}
+
+// AllTyped calls AllTypedFunc
+func (m *WebhookEndpointService) AllTyped(options ...processout.WebhookEndpointAllParameters) (*processout.TypedIterator[*processout.WebhookEndpoint], error) {
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	return m.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext calls AllTypedWithContextFunc
+func (m *WebhookEndpointService) AllTypedWithContext(ctx context.Context, options ...processout.WebhookEndpointAllParameters) (*processout.TypedIterator[*processout.WebhookEndpoint], error) {
+	if m.AllTypedWithContextFunc != nil {
+		return m.AllTypedWithContextFunc(ctx, options...)
+	}
+	if m.AllTypedFunc != nil {
+		return m.AllTypedFunc(options...)
+	}
+
+	it, err := m.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &processout.TypedIterator[*processout.WebhookEndpoint]{Iterator: it}, nil
+}
+
+// Create calls CreateFunc
+func (m *WebhookEndpointService) Create(options ...processout.WebhookEndpointCreateParameters) (*processout.WebhookEndpoint, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	return m.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext calls CreateWithContextFunc
+func (m *WebhookEndpointService) CreateWithContext(ctx context.Context, options ...processout.WebhookEndpointCreateParameters) (*processout.WebhookEndpoint, error) {
+	if m.CreateWithContextFunc != nil {
+		return m.CreateWithContextFunc(ctx, options...)
+	}
+	if m.CreateFunc != nil {
+		return m.CreateFunc(options...)
+	}
+
+	panic("processoutmock: WebhookEndpointService.Create is not mocked")
+}
+
+// Find calls FindFunc
+func (m *WebhookEndpointService) Find(webhookEndpointID string, options ...processout.WebhookEndpointFindParameters) (*processout.WebhookEndpoint, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(webhookEndpointID, options...)
+	}
+
+	return m.FindWithContext(context.Background(), webhookEndpointID, options...)
+}
+
+// FindWithContext calls FindWithContextFunc
+func (m *WebhookEndpointService) FindWithContext(ctx context.Context, webhookEndpointID string, options ...processout.WebhookEndpointFindParameters) (*processout.WebhookEndpoint, error) {
+	if m.FindWithContextFunc != nil {
+		return m.FindWithContextFunc(ctx, webhookEndpointID, options...)
+	}
+	if m.FindFunc != nil {
+		return m.FindFunc(webhookEndpointID, options...)
+	}
+
+	panic("processoutmock: WebhookEndpointService.Find is not mocked")
+}
+
+// Save calls SaveFunc
+func (m *WebhookEndpointService) Save(options ...processout.WebhookEndpointSaveParameters) (*processout.WebhookEndpoint, error) {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	return m.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext calls SaveWithContextFunc
+func (m *WebhookEndpointService) SaveWithContext(ctx context.Context, options ...processout.WebhookEndpointSaveParameters) (*processout.WebhookEndpoint, error) {
+	if m.SaveWithContextFunc != nil {
+		return m.SaveWithContextFunc(ctx, options...)
+	}
+	if m.SaveFunc != nil {
+		return m.SaveFunc(options...)
+	}
+
+	panic("processoutmock: WebhookEndpointService.Save is not mocked")
+}
+
+// Delete calls DeleteFunc
+func (m *WebhookEndpointService) Delete(options ...processout.WebhookEndpointDeleteParameters) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	return m.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext calls DeleteWithContextFunc
+func (m *WebhookEndpointService) DeleteWithContext(ctx context.Context, options ...processout.WebhookEndpointDeleteParameters) error {
+	if m.DeleteWithContextFunc != nil {
+		return m.DeleteWithContextFunc(ctx, options...)
+	}
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(options...)
+	}
+
+	panic("processoutmock: WebhookEndpointService.Delete is not mocked")
+}
@@ -0,0 +1,22 @@
+// Package processoutprom exposes the metrics of the ProcessOut API calls to
+// Prometheus:
+//
+//	rec := processoutprom.NewRecorder()
+//	prometheus.MustRegister(rec)
+//	client.Metrics = rec
+//
+// The recorder exports the following metrics, labelled by resource, such as
+// Invoice, operation, such as Capture, HTTP status and error code:
+//
+//	processout_requests_total
+//	processout_request_errors_total
+//	processout_request_duration_seconds
+//
+// The status label is 0 when no response was received. The duration is
+// not labelled by error code.
+//
+// The package is a module of its own, so that the Prometheus client isn't
+// required by the clients not using it:
+//
+//	go get gopkg.in/processout.v4/processoutprom
+package processoutprom
@@ -0,0 +1,112 @@
+package processoutprom
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"gopkg.in/processout.v4"
+)
+
+// config is the configuration of a Recorder
+type config struct {
+	namespace   string
+	constLabels prometheus.Labels
+	buckets     []float64
+}
+
+// Option configures a Recorder
+type Option func(*config)
+
+// WithNamespace sets the namespace of the metrics, processout by default
+func WithNamespace(namespace string) Option {
+	return func(c *config) {
+		c.namespace = namespace
+	}
+}
+
+// WithConstLabels sets labels added to all the metrics, such as the name
+// of the ProcessOut project when using several clients
+func WithConstLabels(labels prometheus.Labels) Option {
+	return func(c *config) {
+		c.constLabels = labels
+	}
+}
+
+// WithBuckets sets the buckets of the duration histogram, in seconds. The
+// default buckets go from 50ms to 60s
+func WithBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.buckets = buckets
+	}
+}
+
+// Recorder is a processout.MetricsRecorder exposing the metrics of the API
+// calls as a Prometheus collector
+type Recorder struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+var _ processout.MetricsRecorder = (*Recorder)(nil)
+var _ prometheus.Collector = (*Recorder)(nil)
+
+// NewRecorder creates a new Recorder. It must be registered with a
+// Prometheus registry to be collected
+func NewRecorder(opts ...Option) *Recorder {
+	c := &config{
+		namespace: "processout",
+		buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}
+	for _, o := range opts {
+		o(c)
+	}
+
+	labels := []string{"resource", "operation", "status", "error_code"}
+	return &Recorder{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   c.namespace,
+			Name:        "requests_total",
+			Help:        "Number of requests made to the ProcessOut API.",
+			ConstLabels: c.constLabels,
+		}, labels),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   c.namespace,
+			Name:        "request_errors_total",
+			Help:        "Number of requests made to the ProcessOut API that failed.",
+			ConstLabels: c.constLabels,
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   c.namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Duration of the requests made to the ProcessOut API.",
+			ConstLabels: c.constLabels,
+			Buckets:     c.buckets,
+		}, labels[:3]),
+	}
+}
+
+// RecordCall implements processout.MetricsRecorder
+func (r *Recorder) RecordCall(m processout.CallMetrics) {
+	status := strconv.Itoa(m.StatusCode)
+	r.requests.WithLabelValues(m.Resource, m.Method, status, m.ErrorCode).Inc()
+	if m.Failed() {
+		r.errors.WithLabelValues(m.Resource, m.Method, status, m.ErrorCode).Inc()
+	}
+	r.duration.WithLabelValues(m.Resource, m.Method, status).Observe(m.Duration.Seconds())
+}
+
+// Describe implements prometheus.Collector
+func (r *Recorder) Describe(ch chan<- *prometheus.Desc) {
+	r.requests.Describe(ch)
+	r.errors.Describe(ch)
+	r.duration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (r *Recorder) Collect(ch chan<- prometheus.Metric) {
+	r.requests.Collect(ch)
+	r.errors.Collect(ch)
+	r.duration.Collect(ch)
+}
@@ -0,0 +1,57 @@
+package processoutprom
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"gopkg.in/processout.v4"
+	"gopkg.in/processout.v4/processouttest"
+)
+
+func TestRecorder(t *testing.T) {
+	srv := processouttest.NewServer()
+	defer srv.Close()
+
+	rec := NewRecorder(WithConstLabels(prometheus.Labels{"project": "test"}))
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(rec)
+	c := srv.Client()
+	c.Metrics = rec
+
+	iv, err := c.NewInvoice(&processout.Invoice{
+		Name:     processout.String("Test invoice"),
+		Amount:   processout.String("10.00"),
+		Currency: processout.String("USD"),
+	}).Create()
+	if err != nil {
+		t.Fatalf("The invoice could not be created: %s", err.Error())
+	}
+	if _, err := iv.Capture(processouttest.SourceFraud); err == nil {
+		t.Fatalf("The capture should have been declined")
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("The metrics could not be gathered: %s", err.Error())
+	}
+	found := map[string]int{}
+	for _, f := range families {
+		found[f.GetName()] = len(f.GetMetric())
+		if f.GetName() != "processout_request_errors_total" {
+			continue
+		}
+		labels := map[string]string{}
+		for _, l := range f.GetMetric()[0].GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		if labels["resource"] != "Invoice" || labels["operation"] != "Capture" ||
+			labels["status"] != "402" || labels["error_code"] != "card.suspected-fraud" || labels["project"] != "test" {
+			t.Errorf("Unexpected error labels %v", labels)
+		}
+	}
+	if found["processout_requests_total"] != 2 || found["processout_request_errors_total"] != 1 ||
+		found["processout_request_duration_seconds"] != 2 {
+		t.Errorf("Unexpected metrics %v", found)
+	}
+}
@@ -0,0 +1,423 @@
+package processouttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// RecordEnv is the environment variable making NewCassette record the
+// interactions with the API instead of replaying them, when set to a
+// non-empty value
+const RecordEnv = "PROCESSOUT_RECORD"
+
+// scrubbed replaces the secrets and card data in the recorded interactions
+const scrubbed = "[scrubbed]"
+
+var (
+	// scrubbedHeaders are the headers whose value is never recorded
+	scrubbedHeaders = []string{
+		"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie",
+		"X-ProcessOut-Signature",
+	}
+	// scrubbedFields are the JSON fields whose value is never recorded
+	scrubbedFields = map[string]bool{
+		"number": true, "card_number": true, "cvc": true, "cvc2": true,
+		"cvv": true, "exp_month": true, "exp_year": true, "expiry": true,
+		"secret": true, "project_secret": true, "private_key": true,
+		"password": true,
+	}
+	// panPattern matches the digit runs that may be card numbers
+	panPattern = regexp.MustCompile(`\d(?:[ -]?\d){12,18}`)
+	// gatewayRequestPattern matches the gateway requests, which embed the
+	// card data sent to the payment gateways. A payload is required so that
+	// the scrubbed requests are left as is when the cassette is loaded
+	gatewayRequestPattern = regexp.MustCompile(`gway_req_[A-Za-z0-9+/=_-]+`)
+)
+
+// Interaction is a recorded request to the API along with its response
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is a recorded request
+type CassetteRequest struct {
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+	CassetteBody
+}
+
+// CassetteResponse is a recorded response
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	CassetteBody
+}
+
+// CassetteBody is a recorded body. JSON bodies are kept as is so that the
+// cassettes are readable, other bodies are kept as text
+type CassetteBody struct {
+	Body json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+func newCassetteBody(b []byte) CassetteBody {
+	v, ok := scrubJSON(b)
+	if !ok {
+		return CassetteBody{Text: scrubString(string(b))}
+	}
+
+	raw, _ := json.Marshal(v)
+	return CassetteBody{Body: raw}
+}
+
+func (b CassetteBody) bytes() []byte {
+	if len(b.Body) > 0 {
+		return b.Body
+	}
+
+	return []byte(b.Text)
+}
+
+func (b CassetteBody) equal(o CassetteBody) bool {
+	return bytes.Equal(b.Body, o.Body) && b.Text == o.Text
+}
+
+// UnmatchedRequestError is returned when replaying a request that was not
+// recorded in the cassette
+type UnmatchedRequestError struct {
+	Request CassetteRequest
+}
+
+// Error implements the error interface
+func (e *UnmatchedRequestError) Error() string {
+	return fmt.Sprintf("processouttest: no recorded interaction matches %s %s %s",
+		e.Request.Method, e.Request.Path, e.Request.bytes())
+}
+
+// Cassette is an http.RoundTripper recording the interactions with the API
+// to a JSON file, or replaying them from it. Secrets, such as the
+// credentials, card data and gateway requests are scrubbed from the
+// recorded interactions.
+//
+// Requests are replayed with the first recorded interaction that wasn't
+// replayed yet and has the same method, path and body, once scrubbed.
+// Requests matching none fail with an UnmatchedRequestError
+type Cassette struct {
+	// Transport sends the requests while recording. http.DefaultTransport
+	// is used when nil
+	Transport http.RoundTripper
+
+	path      string
+	recording bool
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+	unmatched    []*UnmatchedRequestError
+}
+
+// RecordCassette creates a new cassette recording the interactions to the
+// file at the given path once saved
+func RecordCassette(path string) *Cassette {
+	return &Cassette{
+		path:      path,
+		recording: true,
+	}
+}
+
+// LoadCassette loads the cassette recorded at the given path to replay its
+// interactions
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cassette{path: path}
+	if err := json.Unmarshal(b, &c.interactions); err != nil {
+		return nil, fmt.Errorf("processouttest: the cassette %s is invalid: %w", path, err)
+	}
+	for _, in := range c.interactions {
+		// Indenting the cassette changed the layout of the JSON bodies,
+		// which are compared once normalized
+		in.Request.CassetteBody = newCassetteBody(in.Request.bytes())
+	}
+	c.replayed = make([]bool, len(c.interactions))
+	return c, nil
+}
+
+// NewCassette returns the cassette stored at the given path for the test.
+// The cassette is replayed, and the test fails if it doesn't exist or if
+// requests don't match its interactions. When the RecordEnv environment
+// variable is set, the interactions are recorded instead and the cassette
+// is saved once the test is done
+func NewCassette(t testing.TB, path string) *Cassette {
+	t.Helper()
+
+	if os.Getenv(RecordEnv) != "" {
+		c := RecordCassette(path)
+		t.Cleanup(func() {
+			if err := c.Save(); err != nil {
+				t.Errorf("processouttest: the cassette %s could not be saved: %s", path, err.Error())
+			}
+		})
+		return c
+	}
+
+	c, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("processouttest: the cassette could not be loaded, it can be recorded by setting %s=1: %s",
+			RecordEnv, err.Error())
+	}
+	t.Cleanup(func() {
+		for _, err := range c.Unmatched() {
+			t.Error(err.Error())
+		}
+	})
+	return c
+}
+
+// Client returns an HTTP client sending its requests through the cassette,
+// to be used as the HTTPClient of a ProcessOut client
+func (c *Cassette) Client() *http.Client {
+	return &http.Client{Transport: c}
+}
+
+// Unmatched returns the errors of the replayed requests that matched no
+// recorded interaction
+func (c *Cassette) Unmatched() []*UnmatchedRequestError {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*UnmatchedRequestError(nil), c.unmatched...)
+}
+
+// RoundTrip implements http.RoundTripper
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := []byte{}
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	recorded := CassetteRequest{
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		Query:        req.URL.RawQuery,
+		Header:       scrubHeader(req.Header),
+		CassetteBody: newCassetteBody(body),
+	}
+	if c.recording {
+		return c.record(req, body, recorded)
+	}
+
+	return c.replay(req, recorded)
+}
+
+func (c *Cassette) record(req *http.Request, body []byte, recorded CassetteRequest) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	c.mu.Lock()
+	c.interactions = append(c.interactions, &Interaction{
+		Request: recorded,
+		Response: CassetteResponse{
+			StatusCode:   res.StatusCode,
+			Header:       scrubHeader(res.Header),
+			CassetteBody: newCassetteBody(resBody),
+		},
+	})
+	c.mu.Unlock()
+
+	return res, nil
+}
+
+func (c *Cassette) replay(req *http.Request, recorded CassetteRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, in := range c.interactions {
+		if c.replayed[i] || in.Request.Method != recorded.Method ||
+			in.Request.Path != recorded.Path || !in.Request.equal(recorded.CassetteBody) {
+			continue
+		}
+		c.replayed[i] = true
+
+		b := in.Response.bytes()
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(b)),
+			ContentLength: int64(len(b)),
+			Request:       req,
+		}, nil
+	}
+
+	err := &UnmatchedRequestError{Request: recorded}
+	c.unmatched = append(c.unmatched, err)
+	return nil, err
+}
+
+// Save writes the recorded interactions to the file of the cassette
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, append(b, '\n'), 0644)
+}
+
+// scrubHeader copies the header, scrubbing the values of the headers
+// carrying secrets
+func scrubHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range scrubbedHeaders {
+		if _, ok := h[name]; ok {
+			h.Set(name, scrubbed)
+		}
+	}
+
+	return h
+}
+
+// scrubJSON decodes the JSON body, scrubbing the secrets and card data it
+// contains. It returns false if the body isn't JSON
+func scrubJSON(b []byte) (interface{}, bool) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil, false
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, false
+	}
+
+	return scrubValue(v), true
+}
+
+func scrubValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if scrubbedFields[strings.ToLower(k)] {
+				v[k] = scrubField(e)
+				continue
+			}
+			v[k] = scrubValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = scrubValue(e)
+		}
+	case string:
+		return scrubString(v)
+	case json.Number:
+		if scrubPAN(string(v)) == scrubbed {
+			return json.Number("0")
+		}
+	}
+
+	return v
+}
+
+// scrubString scrubs the gateway requests and card numbers of the string
+func scrubString(s string) string {
+	s = gatewayRequestPattern.ReplaceAllString(s, "gway_req_"+scrubbed)
+	return panPattern.ReplaceAllStringFunc(s, scrubPAN)
+}
+
+// scrubField scrubs the value of a field carrying secrets or card data. The
+// JSON type of the value is kept, so that the replayed bodies still decode
+// into the resources, such as the numeric expiry dates of the cards
+func scrubField(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = scrubField(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = scrubField(e)
+		}
+	case string:
+		return scrubbed
+	case json.Number:
+		return json.Number("0")
+	case bool:
+		return false
+	}
+
+	return v
+}
+
+// scrubPAN scrubs the digit run if it is a valid card number
+func scrubPAN(s string) string {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(s)
+	if len(digits) < 13 || len(digits) > 19 || !luhn(digits) {
+		return s
+	}
+
+	return scrubbed
+}
+
+// luhn returns whether or not the digits pass the Luhn checksum used by
+// card numbers
+func luhn(digits string) bool {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+
+	return sum%10 == 0
+}
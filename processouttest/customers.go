@@ -0,0 +1,308 @@
+package processouttest
+
+import (
+	"net/http"
+	"strings"
+
+	"gopkg.in/processout.v4"
+	"gopkg.in/processout.v4/errors"
+)
+
+// customerFields are the fields of a customer that can be updated
+var customerFields = []string{
+	"balance", "default_token_id", "email", "first_name", "last_name",
+	"address1", "address2", "city", "state", "zip", "country_code",
+	"ip_address", "phone_number", "legal_document", "date_of_birth",
+	"is_business", "sex", "metadata", "registered_at",
+}
+
+func (s *Server) routeCustomers(mux *http.ServeMux) {
+	s.handle(mux, "GET /customers", s.listCustomers)
+	s.handle(mux, "POST /customers", s.createCustomer)
+	s.handle(mux, "GET /customers/{id}", s.findCustomer)
+	s.handle(mux, "PUT /customers/{id}", s.saveCustomer)
+	s.handle(mux, "DELETE /customers/{id}", s.deleteCustomer)
+	s.handle(mux, "GET /customers/{id}/transactions", s.listCustomerTransactions)
+	s.handle(mux, "GET /customers/{id}/subscriptions", s.listCustomerSubscriptions)
+
+	s.handle(mux, "GET /customers/{id}/tokens", s.listTokens)
+	s.handle(mux, "POST /customers/{id}/tokens", s.createToken)
+	s.handle(mux, "GET /customers/{id}/tokens/{token}", s.findToken)
+	s.handle(mux, "PUT /customers/{id}/tokens/{token}", s.saveToken)
+	s.handle(mux, "DELETE /customers/{id}/tokens/{token}", s.deleteToken)
+}
+
+func (s *Server) listCustomers(w http.ResponseWriter, r *http.Request) {
+	l, hasMore := s.customers.page(r, nil)
+	replyList(w, "customers", l, hasMore)
+}
+
+func (s *Server) createCustomer(w http.ResponseWriter, r *http.Request) {
+	cust := &processout.Customer{}
+	if !decode(w, r, cust) {
+		return
+	}
+	if cust.ID == nil || *cust.ID == "" {
+		cust.ID = s.newID("cust")
+	} else if _, ok := s.customers.get(*cust.ID); ok {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"A customer with the ID "+*cust.ID+" already exists")
+		return
+	}
+
+	cust.ProjectID = processout.String(s.ProjectID)
+	cust.DefaultTokenID = nil
+	cust.Sandbox = processout.Bool(true)
+	cust.CreatedAt = s.now()
+	s.customers.add(*cust.ID, cust)
+
+	s.emit("customer.created", "customer", cust)
+	reply(w, "customer", cust)
+}
+
+func (s *Server) findCustomer(w http.ResponseWriter, r *http.Request) {
+	cust, ok := s.customers.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+
+	reply(w, "customer", cust)
+}
+
+func (s *Server) saveCustomer(w http.ResponseWriter, r *http.Request) {
+	cust, ok := s.customers.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+	update := *cust
+	if !merge(w, r, &update, customerFields...) {
+		return
+	}
+	defaultTokenID := update.DefaultTokenID
+	if defaultTokenID != nil && !s.customerToken(cust, *defaultTokenID) {
+		notFound(w, "token")
+		return
+	}
+
+	update.DefaultTokenID = cust.DefaultTokenID
+	*cust = update
+	if defaultTokenID != nil {
+		tok, _ := s.tokens.get(*defaultTokenID)
+		s.setDefaultToken(cust, tok)
+	}
+	s.emit("customer.updated", "customer", cust)
+	reply(w, "customer", cust)
+}
+
+func (s *Server) deleteCustomer(w http.ResponseWriter, r *http.Request) {
+	cust, ok := s.customers.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+
+	s.customers.remove(*cust.ID)
+	s.emit("customer.deleted", "customer", cust)
+	reply(w, "", nil)
+}
+
+func (s *Server) listCustomerTransactions(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.customers.get(id); !ok {
+		notFound(w, "customer")
+		return
+	}
+
+	l, hasMore := s.transactions.page(r, func(tr *processout.Transaction) bool {
+		return tr.CustomerID != nil && *tr.CustomerID == id
+	})
+	replyList(w, "transactions", l, hasMore)
+}
+
+func (s *Server) listCustomerSubscriptions(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.customers.get(id); !ok {
+		notFound(w, "customer")
+		return
+	}
+
+	l, hasMore := s.subscriptions.page(r, func(sub *processout.Subscription) bool {
+		return sub.CustomerID != nil && *sub.CustomerID == id
+	})
+	replyList(w, "subscriptions", l, hasMore)
+}
+
+// customerToken returns whether or not the token belongs to the customer
+func (s *Server) customerToken(cust *processout.Customer, tokenID string) bool {
+	tok, ok := s.tokens.get(tokenID)
+	return ok && *tok.CustomerID == *cust.ID
+}
+
+// tokenBody is the body of the token creation and update requests
+type tokenBody struct {
+	Source      string             `json:"source"`
+	Verify      *bool              `json:"verify"`
+	SetDefault  *bool              `json:"set_default"`
+	Metadata    *map[string]string `json:"metadata"`
+	Description *string            `json:"description"`
+	ReturnURL   *string            `json:"return_url"`
+	CancelURL   *string            `json:"cancel_url"`
+}
+
+func (s *Server) listTokens(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.customers.get(id); !ok {
+		notFound(w, "customer")
+		return
+	}
+
+	l, hasMore := s.tokens.page(r, func(tok *processout.Token) bool {
+		return *tok.CustomerID == id
+	})
+	replyList(w, "tokens", l, hasMore)
+}
+
+func (s *Server) createToken(w http.ResponseWriter, r *http.Request) {
+	cust, ok := s.customers.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+	body := tokenBody{}
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.Source == "" {
+		failFields(w, "source")
+		return
+	}
+	source, ok := s.tokenSource(w, body)
+	if !ok {
+		return
+	}
+
+	tok := &processout.Token{
+		ID:           s.newID("tok"),
+		CustomerID:   cust.ID,
+		Type:         processout.String("card"),
+		Metadata:     body.Metadata,
+		Description:  body.Description,
+		ReturnURL:    body.ReturnURL,
+		CancelURL:    body.CancelURL,
+		IsChargeable: processout.Bool(true),
+		IsDefault:    processout.Bool(false),
+		CreatedAt:    s.now(),
+	}
+	if strings.HasPrefix(source, "card_") {
+		tok.CardID = processout.String(source)
+	}
+	s.tokens.add(*tok.ID, tok)
+	s.sources[*tok.ID] = source
+	if cust.DefaultTokenID == nil || (body.SetDefault != nil && *body.SetDefault) {
+		s.setDefaultToken(cust, tok)
+	}
+
+	s.emit("token.created", "token", tok)
+	reply(w, "token", tok)
+}
+
+// tokenSource resolves the source of the token. When the source is to be
+// verified, it writes the error response and returns false if it is
+// declined
+func (s *Server) tokenSource(w http.ResponseWriter, body tokenBody) (string, bool) {
+	source, tokenID, ok := s.source(body.Source)
+	if !ok || tokenID != "" {
+		notFound(w, "payment source")
+		return "", false
+	}
+	if body.Verify != nil && *body.Verify {
+		if code := outcome(source, nil); code != "" {
+			fail(w, http.StatusPaymentRequired, code, "The verification of the payment source was declined")
+			return "", false
+		}
+	}
+
+	return source, true
+}
+
+// setDefaultToken makes the token the default one of the customer
+func (s *Server) setDefaultToken(cust *processout.Customer, tok *processout.Token) {
+	if cust.DefaultTokenID != nil {
+		if prev, ok := s.tokens.get(*cust.DefaultTokenID); ok {
+			prev.IsDefault = processout.Bool(false)
+		}
+	}
+
+	cust.DefaultTokenID = tok.ID
+	tok.IsDefault = processout.Bool(true)
+}
+
+// findCustomerToken returns the token of the path, writing the error
+// response if it doesn't belong to the customer of the path
+func (s *Server) findCustomerToken(w http.ResponseWriter, r *http.Request) (*processout.Customer, *processout.Token, bool) {
+	cust, ok := s.customers.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "customer")
+		return nil, nil, false
+	}
+	if !s.customerToken(cust, r.PathValue("token")) {
+		notFound(w, "token")
+		return nil, nil, false
+	}
+
+	tok, _ := s.tokens.get(r.PathValue("token"))
+	return cust, tok, true
+}
+
+func (s *Server) findToken(w http.ResponseWriter, r *http.Request) {
+	_, tok, ok := s.findCustomerToken(w, r)
+	if !ok {
+		return
+	}
+
+	reply(w, "token", tok)
+}
+
+func (s *Server) saveToken(w http.ResponseWriter, r *http.Request) {
+	cust, tok, ok := s.findCustomerToken(w, r)
+	if !ok {
+		return
+	}
+	body := tokenBody{}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	if body.Source != "" {
+		source, ok := s.tokenSource(w, body)
+		if !ok {
+			return
+		}
+		s.sources[*tok.ID] = source
+		tok.CardID = nil
+		if strings.HasPrefix(source, "card_") {
+			tok.CardID = processout.String(source)
+		}
+	}
+	if body.SetDefault != nil && *body.SetDefault {
+		s.setDefaultToken(cust, tok)
+	}
+
+	reply(w, "", nil)
+}
+
+func (s *Server) deleteToken(w http.ResponseWriter, r *http.Request) {
+	cust, tok, ok := s.findCustomerToken(w, r)
+	if !ok {
+		return
+	}
+
+	s.tokens.remove(*tok.ID)
+	delete(s.sources, *tok.ID)
+	if cust.DefaultTokenID != nil && *cust.DefaultTokenID == *tok.ID {
+		cust.DefaultTokenID = nil
+	}
+	reply(w, "", nil)
+}
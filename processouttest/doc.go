@@ -0,0 +1,30 @@
+// Package processouttest provides an in-memory fake of the ProcessOut API,
+// so that payment flows can be tested offline and deterministically:
+//
+//	srv := processouttest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	iv, _ := client.NewInvoice(&processout.Invoice{
+//		Name:     processout.String("Test invoice"),
+//		Amount:   processout.String(processouttest.AmountDeclined),
+//		Currency: processout.String("USD"),
+//	}).Create()
+//	_, err := iv.Capture(processouttest.SourceApproved)
+//	// err is a *errors.ValidationError with the card.declined code
+//
+// The fake implements the invoices, their authorization, capture and void,
+// the transactions and their refunds, the customers and their tokens, the
+// subscriptions, plans, coupons and events. The IDs of the created
+// resources and the dates are deterministic, so that tests can assert on
+// them.
+//
+// Payments are approved unless their source or amount is one of the magic
+// values declared by the package, in which case they are declined or
+// require 3-D Secure.
+//
+// The package also provides cassettes, recording the interactions with the
+// real API once so that they can be replayed later without network access:
+//
+//	client.HTTPClient = processouttest.NewCassette(t, "testdata/capture.json").Client()
+package processouttest
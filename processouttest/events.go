@@ -0,0 +1,37 @@
+package processouttest
+
+import (
+	"net/http"
+)
+
+func (s *Server) routeEvents(mux *http.ServeMux) {
+	s.handle(mux, "GET /events", s.listEvents)
+	s.handle(mux, "GET /events/{id}", s.findEvent)
+	s.handle(mux, "GET /events/{id}/webhooks", s.listEventWebhooks)
+}
+
+func (s *Server) listEvents(w http.ResponseWriter, r *http.Request) {
+	l, hasMore := s.events.page(r, nil)
+	replyList(w, "events", l, hasMore)
+}
+
+func (s *Server) findEvent(w http.ResponseWriter, r *http.Request) {
+	ev, ok := s.events.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "event")
+		return
+	}
+
+	reply(w, "event", ev)
+}
+
+// listEventWebhooks lists the webhooks sent for the event. The fake doesn't
+// send any webhook, so the list is always empty
+func (s *Server) listEventWebhooks(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.events.get(r.PathValue("id")); !ok {
+		notFound(w, "event")
+		return
+	}
+
+	replyList(w, "webhooks", []interface{}{}, false)
+}
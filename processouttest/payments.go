@@ -0,0 +1,500 @@
+package processouttest
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"gopkg.in/processout.v4"
+	"gopkg.in/processout.v4/errors"
+)
+
+func (s *Server) routePayments(mux *http.ServeMux) {
+	s.handle(mux, "GET /invoices", s.listInvoices)
+	s.handle(mux, "POST /invoices", s.createInvoice)
+	s.handle(mux, "GET /invoices/{id}", s.findInvoice)
+	s.handle(mux, "POST /invoices/{id}/authorize", s.authorizeInvoice)
+	s.handle(mux, "POST /invoices/{id}/capture", s.captureInvoice)
+	s.handle(mux, "POST /invoices/{id}/void", s.voidInvoice)
+	s.handle(mux, "POST /invoices/{id}/three-d-s", s.initiateThreeDS)
+	s.handle(mux, "GET /invoices/{id}/transactions", s.findInvoiceTransaction)
+	s.handle(mux, "GET /invoices/{id}/customers", s.findInvoiceCustomer)
+	s.handle(mux, "POST /invoices/{id}/customers", s.assignInvoiceCustomer)
+
+	s.handle(mux, "GET /transactions", s.listTransactions)
+	s.handle(mux, "GET /transactions/{id}", s.findTransaction)
+	s.handle(mux, "GET /transactions/{id}/refunds", s.listRefunds)
+	s.handle(mux, "POST /transactions/{id}/refunds", s.createRefund)
+	s.handle(mux, "GET /transactions/{id}/refunds/{refund}", s.findRefund)
+
+	// The page the customers are redirected to for 3-D Secure isn't part of
+	// the API, and is opened without credentials
+	mux.HandleFunc("GET /test/three-d-s/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if !s.AuthenticateThreeDS(r.PathValue("id")) {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("The customer was authenticated"))
+	})
+}
+
+// AuthenticateThreeDS marks the customer paying the invoice as
+// authenticated using 3-D Secure, as if they had completed the customer
+// action returned when initiating 3-D Secure. The following payments of the
+// invoice requiring 3-D Secure are then approved. It returns false if the
+// invoice doesn't exist
+func (s *Server) AuthenticateThreeDS(invoiceID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.invoices.get(invoiceID); !ok {
+		return false
+	}
+	s.authenticated[invoiceID] = true
+	return true
+}
+
+func (s *Server) listInvoices(w http.ResponseWriter, r *http.Request) {
+	l, hasMore := s.invoices.page(r, nil)
+	replyList(w, "invoices", l, hasMore)
+}
+
+func (s *Server) createInvoice(w http.ResponseWriter, r *http.Request) {
+	iv := &processout.Invoice{}
+	if !decode(w, r, iv) {
+		return
+	}
+	if m := missing(map[string]interface{}{
+		"name":     iv.Name,
+		"amount":   iv.Amount,
+		"currency": iv.Currency,
+	}); len(m) > 0 {
+		failFields(w, m...)
+		return
+	}
+	if a, ok := parseAmount(iv.Amount); !ok || a.Sign() <= 0 {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The amount must be a positive decimal number")
+		return
+	}
+	if iv.CustomerID != nil {
+		if _, ok := s.customers.get(*iv.CustomerID); !ok {
+			notFound(w, "customer")
+			return
+		}
+	}
+
+	iv.ID = s.newID("iv")
+	iv.ProjectID = processout.String(s.ProjectID)
+	iv.URL = processout.String(s.URL + "/test/checkout/" + *iv.ID)
+	iv.Sandbox = processout.Bool(true)
+	iv.CreatedAt = s.now()
+	s.invoices.add(*iv.ID, iv)
+
+	s.emit("invoice.created", "invoice", iv)
+	reply(w, "invoice", iv)
+}
+
+func (s *Server) findInvoice(w http.ResponseWriter, r *http.Request) {
+	iv, ok := s.invoices.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "invoice")
+		return
+	}
+
+	reply(w, "invoice", iv)
+}
+
+// paymentBody is the body of the authorization and capture requests
+type paymentBody struct {
+	Source        string          `json:"source"`
+	CaptureAmount json.RawMessage `json:"capture_amount"`
+	AuthorizeOnly *bool           `json:"authorize_only"`
+}
+
+func (s *Server) authorizeInvoice(w http.ResponseWriter, r *http.Request) {
+	s.pay(w, r, false)
+}
+
+func (s *Server) captureInvoice(w http.ResponseWriter, r *http.Request) {
+	s.pay(w, r, true)
+}
+
+// pay authorizes the payment of the invoice, and captures it if requested
+func (s *Server) pay(w http.ResponseWriter, r *http.Request, capture bool) {
+	iv, ok := s.invoices.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "invoice")
+		return
+	}
+	body := paymentBody{}
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.AuthorizeOnly != nil && *body.AuthorizeOnly {
+		capture = false
+	}
+
+	tr := s.invoiceTransaction(iv)
+	switch {
+	case *tr.Voided:
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The transaction of the invoice was voided")
+		return
+	case *tr.Captured:
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The transaction of the invoice was already captured")
+		return
+	case *tr.Authorized && !capture:
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The transaction of the invoice was already authorized")
+		return
+	}
+
+	if !*tr.Authorized && !s.authorize(w, iv, tr, body.Source) {
+		return
+	}
+	if capture && !s.capture(w, tr, rawAmount(body.CaptureAmount)) {
+		return
+	}
+
+	reply(w, "transaction", tr)
+}
+
+// invoiceTransaction returns the transaction of the invoice, creating it on
+// the first payment attempt
+func (s *Server) invoiceTransaction(iv *processout.Invoice) *processout.Transaction {
+	if iv.TransactionID != nil {
+		if tr, ok := s.transactions.get(*iv.TransactionID); ok {
+			return tr
+		}
+	}
+
+	zero := new(big.Rat)
+	tr := &processout.Transaction{
+		ID:               s.newID("tr"),
+		ProjectID:        processout.String(s.ProjectID),
+		InvoiceID:        iv.ID,
+		CustomerID:       iv.CustomerID,
+		Name:             iv.Name,
+		Amount:           iv.Amount,
+		AuthorizedAmount: formatAmount(zero, iv.Amount),
+		CapturedAmount:   formatAmount(zero, iv.Amount),
+		RefundedAmount:   formatAmount(zero, iv.Amount),
+		AvailableAmount:  formatAmount(zero, iv.Amount),
+		Currency:         iv.Currency,
+		GatewayName:      processout.String("sandbox"),
+		Status:           processout.String("waiting"),
+		Authorized:       processout.Bool(false),
+		Captured:         processout.Bool(false),
+		Voided:           processout.Bool(false),
+		Refunded:         processout.Bool(false),
+		Chargedback:      processout.Bool(false),
+		Metadata:         iv.Metadata,
+		Sandbox:          processout.Bool(true),
+		CreatedAt:        s.now(),
+	}
+	s.transactions.add(*tr.ID, tr)
+	iv.TransactionID = tr.ID
+
+	return tr
+}
+
+// source resolves the payment source, returning the ID of its token if it
+// is one
+func (s *Server) source(src string) (source, tokenID string, ok bool) {
+	if _, ok := s.tokens.get(src); ok {
+		return s.sources[src], src, true
+	}
+	if _, ok := sourceOutcomes[src]; ok || src == SourceApproved || strings.HasPrefix(src, "card_") {
+		return src, "", true
+	}
+
+	return "", "", false
+}
+
+// outcome returns the error code of a payment of the amount from the
+// source, or an empty string if it is approved
+func outcome(source string, amount *string) string {
+	if code, ok := sourceOutcomes[source]; ok {
+		return code
+	}
+
+	a, ok := parseAmount(amount)
+	if !ok {
+		return ""
+	}
+	for magic, code := range amountOutcomes {
+		if m, _ := new(big.Rat).SetString(magic); a.Cmp(m) == 0 {
+			return code
+		}
+	}
+	return ""
+}
+
+// authorize authorizes the transaction of the invoice using the source. It
+// writes the error response and returns false if the payment was declined
+func (s *Server) authorize(w http.ResponseWriter, iv *processout.Invoice, tr *processout.Transaction, src string) bool {
+	if src == "" {
+		failFields(w, "source")
+		return false
+	}
+	source, tokenID, ok := s.source(src)
+	if !ok {
+		notFound(w, "payment source")
+		return false
+	}
+	if tokenID != "" {
+		tr.TokenID = processout.String(tokenID)
+	} else if strings.HasPrefix(source, "card_") {
+		tr.CardID = processout.String(source)
+	}
+
+	code := outcome(source, iv.Amount)
+	if code == errors.CodeCardNeedsAuthentication {
+		if !s.authenticated[*iv.ID] {
+			tr.ThreeDSStatus = processout.String("pending")
+			write(w, http.StatusPaymentRequired, map[string]interface{}{
+				"success":         false,
+				"error_type":      code,
+				"message":         "The payment requires the customer to authenticate using 3-D Secure",
+				"customer_action": s.threeDSAction(iv),
+			})
+			return false
+		}
+		tr.ThreeDSStatus = processout.String("success")
+		code = ""
+	}
+	if code != "" {
+		tr.Status = processout.String("failed")
+		tr.ErrorCode = processout.String(code)
+		tr.ErrorMessage = processout.String("The payment was declined")
+		s.emit("transaction.failed", "transaction", tr)
+		fail(w, http.StatusPaymentRequired, code, *tr.ErrorMessage)
+		return false
+	}
+
+	tr.Status = processout.String("authorized")
+	tr.Authorized = processout.Bool(true)
+	tr.AuthorizedAmount = tr.Amount
+	tr.ErrorCode = nil
+	tr.ErrorMessage = nil
+	s.emit("transaction.authorized", "transaction", tr)
+	return true
+}
+
+// capture captures the authorized transaction, entirely when no amount is
+// given. It writes the error response and returns false if the amount is
+// invalid
+func (s *Server) capture(w http.ResponseWriter, tr *processout.Transaction, amount *string) bool {
+	captured := tr.AuthorizedAmount
+	if amount != nil {
+		a, ok := parseAmount(amount)
+		authorized, _ := parseAmount(tr.AuthorizedAmount)
+		if !ok || a.Sign() <= 0 || a.Cmp(authorized) > 0 {
+			fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+				"The capture amount must be positive and can't exceed the authorized amount")
+			return false
+		}
+		captured = formatAmount(a, tr.Amount)
+	}
+
+	tr.Status = processout.String("completed")
+	tr.Captured = processout.Bool(true)
+	tr.CapturedAmount = captured
+	tr.AvailableAmount = captured
+	s.emit("transaction.captured", "transaction", tr)
+	return true
+}
+
+func (s *Server) voidInvoice(w http.ResponseWriter, r *http.Request) {
+	iv, ok := s.invoices.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "invoice")
+		return
+	}
+	tr := s.invoiceTransaction(iv)
+	if !*tr.Authorized || *tr.Captured || *tr.Voided {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"Only the transactions that are authorized but not captured can be voided")
+		return
+	}
+
+	tr.Status = processout.String("voided")
+	tr.Voided = processout.Bool(true)
+	s.emit("transaction.voided", "transaction", tr)
+	reply(w, "transaction", tr)
+}
+
+func (s *Server) initiateThreeDS(w http.ResponseWriter, r *http.Request) {
+	iv, ok := s.invoices.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "invoice")
+		return
+	}
+	body := paymentBody{}
+	if !decode(w, r, &body) {
+		return
+	}
+	if _, _, ok := s.source(body.Source); !ok {
+		notFound(w, "payment source")
+		return
+	}
+
+	reply(w, "customer_action", s.threeDSAction(iv))
+}
+
+// threeDSAction returns the action redirecting the customer to the page
+// authenticating them
+func (s *Server) threeDSAction(iv *processout.Invoice) *processout.CustomerAction {
+	return &processout.CustomerAction{
+		Type:  processout.String("url"),
+		Value: processout.String(s.URL + "/test/three-d-s/" + *iv.ID),
+	}
+}
+
+func (s *Server) findInvoiceTransaction(w http.ResponseWriter, r *http.Request) {
+	iv, ok := s.invoices.get(r.PathValue("id"))
+	if !ok || iv.TransactionID == nil {
+		notFound(w, "transaction")
+		return
+	}
+
+	tr, _ := s.transactions.get(*iv.TransactionID)
+	reply(w, "transaction", tr)
+}
+
+func (s *Server) findInvoiceCustomer(w http.ResponseWriter, r *http.Request) {
+	iv, ok := s.invoices.get(r.PathValue("id"))
+	if !ok || iv.CustomerID == nil {
+		notFound(w, "customer")
+		return
+	}
+	cust, ok := s.customers.get(*iv.CustomerID)
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+
+	reply(w, "customer", cust)
+}
+
+func (s *Server) assignInvoiceCustomer(w http.ResponseWriter, r *http.Request) {
+	iv, ok := s.invoices.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "invoice")
+		return
+	}
+	body := struct {
+		CustomerID *string `json:"customer_id"`
+	}{}
+	if !decode(w, r, &body) {
+		return
+	}
+	if body.CustomerID == nil {
+		failFields(w, "customer_id")
+		return
+	}
+	cust, ok := s.customers.get(*body.CustomerID)
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+
+	iv.CustomerID = cust.ID
+	if iv.TransactionID != nil {
+		if tr, ok := s.transactions.get(*iv.TransactionID); ok {
+			tr.CustomerID = cust.ID
+		}
+	}
+	reply(w, "customer", cust)
+}
+
+func (s *Server) listTransactions(w http.ResponseWriter, r *http.Request) {
+	l, hasMore := s.transactions.page(r, nil)
+	replyList(w, "transactions", l, hasMore)
+}
+
+func (s *Server) findTransaction(w http.ResponseWriter, r *http.Request) {
+	tr, ok := s.transactions.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "transaction")
+		return
+	}
+
+	reply(w, "transaction", tr)
+}
+
+func (s *Server) listRefunds(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.transactions.get(id); !ok {
+		notFound(w, "transaction")
+		return
+	}
+
+	l, hasMore := s.refunds.page(r, func(ref *processout.Refund) bool {
+		return *ref.TransactionID == id
+	})
+	replyList(w, "refunds", l, hasMore)
+}
+
+func (s *Server) createRefund(w http.ResponseWriter, r *http.Request) {
+	tr, ok := s.transactions.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "transaction")
+		return
+	}
+	ref := &processout.Refund{}
+	if !decode(w, r, ref) {
+		return
+	}
+	if ref.Amount == nil {
+		failFields(w, "amount")
+		return
+	}
+	if !*tr.Captured {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"Only the captured transactions can be refunded")
+		return
+	}
+	a, ok := parseAmount(ref.Amount)
+	available, _ := parseAmount(tr.AvailableAmount)
+	if !ok || a.Sign() <= 0 || a.Cmp(available) > 0 {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The refund amount must be positive and can't exceed the available amount")
+		return
+	}
+
+	ref.ID = s.newID("re")
+	ref.TransactionID = tr.ID
+	ref.Amount = formatAmount(a, tr.Amount)
+	ref.HasFailed = processout.Bool(false)
+	ref.Sandbox = processout.Bool(true)
+	ref.CreatedAt = s.now()
+	s.refunds.add(*ref.ID, ref)
+
+	refunded, _ := parseAmount(tr.RefundedAmount)
+	available.Sub(available, a)
+	tr.RefundedAmount = formatAmount(refunded.Add(refunded, a), tr.Amount)
+	tr.AvailableAmount = formatAmount(available, tr.Amount)
+	tr.Refunded = processout.Bool(true)
+	tr.RefundedAt = ref.CreatedAt
+	tr.Status = processout.String("partially-refunded")
+	if available.Sign() == 0 {
+		tr.Status = processout.String("refunded")
+	}
+	s.emit("transaction.refunded", "transaction", tr)
+
+	reply(w, "refund", ref)
+}
+
+func (s *Server) findRefund(w http.ResponseWriter, r *http.Request) {
+	ref, ok := s.refunds.get(r.PathValue("refund"))
+	if !ok || *ref.TransactionID != r.PathValue("id") {
+		notFound(w, "refund")
+		return
+	}
+
+	reply(w, "refund", ref)
+}
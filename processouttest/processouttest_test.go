@@ -0,0 +1,389 @@
+package processouttest
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/processout.v4"
+	"gopkg.in/processout.v4/errors"
+)
+
+func createInvoice(t *testing.T, c *processout.ProcessOut, amount string) *processout.Invoice {
+	t.Helper()
+
+	iv, err := c.NewInvoice(&processout.Invoice{
+		Name:     processout.String("Test invoice"),
+		Amount:   processout.String(amount),
+		Currency: processout.String("USD"),
+	}).Create()
+	if err != nil {
+		t.Fatalf("The invoice could not be created: %s", err.Error())
+	}
+	return iv
+}
+
+func errorCode(err error) string {
+	if cerr, ok := err.(errors.CodedError); ok {
+		return cerr.Code()
+	}
+	return ""
+}
+
+func TestPaymentLifecycle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	c := srv.Client()
+
+	iv := createInvoice(t, c, "10.00")
+	if *iv.ID != "iv_test000001" {
+		t.Errorf("The invoice ID should be deterministic, got %s", *iv.ID)
+	}
+
+	tr, err := iv.Authorize(SourceApproved)
+	if err != nil {
+		t.Fatalf("The invoice could not be authorized: %s", err.Error())
+	}
+	if *tr.Status != "authorized" || *tr.AuthorizedAmount != "10.00" {
+		t.Errorf("The transaction should be authorized for 10.00, got %s %s", *tr.Status, *tr.AuthorizedAmount)
+	}
+
+	tr, err = iv.Capture(SourceApproved, processout.InvoiceCaptureParameters{CaptureAmount: "8.00"})
+	if err != nil {
+		t.Fatalf("The invoice could not be captured: %s", err.Error())
+	}
+	if *tr.Status != "completed" || *tr.CapturedAmount != "8.00" {
+		t.Errorf("The transaction should be captured for 8.00, got %s %s", *tr.Status, *tr.CapturedAmount)
+	}
+	if _, err := iv.Void(); errorCode(err) != errors.CodeRequestValidationError {
+		t.Errorf("A captured transaction should not be voidable, got %v", err)
+	}
+
+	if err := c.NewRefund(&processout.Refund{
+		TransactionID: tr.ID,
+		Amount:        processout.String("3.00"),
+	}).Create(); err != nil {
+		t.Fatalf("The transaction could not be refunded: %s", err.Error())
+	}
+	if err := c.NewRefund(&processout.Refund{
+		TransactionID: tr.ID,
+		Amount:        processout.String("6.00"),
+	}).Create(); errorCode(err) != errors.CodeRequestValidationError {
+		t.Errorf("Refunding more than available should fail, got %v", err)
+	}
+
+	tr, err = c.NewTransaction().Find(*tr.ID)
+	if err != nil {
+		t.Fatalf("The transaction could not be fetched: %s", err.Error())
+	}
+	if *tr.Status != "partially-refunded" || *tr.AvailableAmount != "5.00" || *tr.RefundedAmount != "3.00" {
+		t.Errorf("Unexpected refunded transaction %s %s %s", *tr.Status, *tr.AvailableAmount, *tr.RefundedAmount)
+	}
+
+	it, err := c.NewEvent().AllTyped()
+	if err != nil {
+		t.Fatalf("The events could not be listed: %s", err.Error())
+	}
+	names := []string{}
+	for it.Next() {
+		names = append(names, *it.Current().Name)
+	}
+	if err := it.Error(); err != nil {
+		t.Fatalf("The events could not be listed: %s", err.Error())
+	}
+	expected := "transaction.refunded,transaction.captured,transaction.authorized,invoice.created"
+	if strings.Join(names, ",") != expected {
+		t.Errorf("The events should be %s, got %v", expected, names)
+	}
+}
+
+func TestPaymentDeclines(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	c := srv.Client()
+
+	cases := []struct {
+		amount, source, code string
+	}{
+		{"10.00", SourceDeclined, errors.CodeCardDeclined},
+		{"10.00", SourceFraud, errors.CodeCardSuspectedFraud},
+		{AmountInsufficientFunds, SourceApproved, errors.CodeCardInsufficientFunds},
+		{AmountGatewayTimeout, "card_test", errors.CodeGatewayTimeout},
+	}
+	for _, tc := range cases {
+		iv := createInvoice(t, c, tc.amount)
+		_, err := iv.Capture(tc.source)
+		if errorCode(err) != tc.code {
+			t.Errorf("Paying %s with %s should fail with %s, got %v", tc.amount, tc.source, tc.code, err)
+		}
+		if verr, ok := err.(*errors.ValidationError); !ok || verr.StatusCode() != http.StatusPaymentRequired {
+			t.Errorf("The decline should be a validation error with a 402 status, got %T", err)
+		}
+
+		tr, err := iv.FetchTransaction()
+		if err != nil {
+			t.Fatalf("The transaction could not be fetched: %s", err.Error())
+		}
+		if *tr.Status != "failed" || *tr.ErrorCode != tc.code {
+			t.Errorf("The transaction should have failed with %s, got %s", tc.code, *tr.Status)
+		}
+	}
+}
+
+func TestThreeDS(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	c := srv.Client()
+
+	iv := createInvoice(t, c, "10.00")
+	_, err := iv.Authorize(SourceThreeDS)
+	if errorCode(err) != errors.CodeCardNeedsAuthentication {
+		t.Fatalf("The payment should require 3-D Secure, got %v", err)
+	}
+
+	action, err := iv.InitiateThreeDS(SourceThreeDS)
+	if err != nil {
+		t.Fatalf("3-D Secure could not be initiated: %s", err.Error())
+	}
+	res, err := http.Get(*action.Value)
+	if err != nil || res.StatusCode != http.StatusOK {
+		t.Fatalf("The customer could not be authenticated: %v", err)
+	}
+	res.Body.Close()
+
+	tr, err := iv.Authorize(SourceThreeDS)
+	if err != nil {
+		t.Fatalf("The payment should be authorized once authenticated: %s", err.Error())
+	}
+	if *tr.ThreeDSStatus != "success" {
+		t.Errorf("The 3-D Secure status should be success, got %s", *tr.ThreeDSStatus)
+	}
+	if tr, err = iv.Void(); err != nil || *tr.Status != "voided" {
+		t.Errorf("The transaction should have been voided, got %v", err)
+	}
+}
+
+func TestSubscriptionLifecycle(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	c := srv.Client()
+
+	cust, err := c.NewCustomer(&processout.Customer{
+		Email: processout.String("john@example.com"),
+	}).Create()
+	if err != nil {
+		t.Fatalf("The customer could not be created: %s", err.Error())
+	}
+
+	_, err = c.NewToken(&processout.Token{CustomerID: cust.ID}).Create(processout.TokenCreateParameters{
+		Source: SourceDeclined,
+		Verify: true,
+	})
+	if errorCode(err) != errors.CodeCardDeclined {
+		t.Errorf("The verification of the token should have been declined, got %v", err)
+	}
+	tok, err := c.NewToken(&processout.Token{CustomerID: cust.ID}).Create(processout.TokenCreateParameters{
+		Source: "card_test",
+		Verify: true,
+	})
+	if err != nil {
+		t.Fatalf("The token could not be created: %s", err.Error())
+	}
+	if !*tok.IsDefault {
+		t.Errorf("The first token of the customer should be its default one")
+	}
+
+	plan, err := c.NewPlan(&processout.Plan{
+		Name:     processout.String("Monthly"),
+		Amount:   processout.String("9.99"),
+		Currency: processout.String("EUR"),
+		Interval: processout.String("1m"),
+	}).Create()
+	if err != nil {
+		t.Fatalf("The plan could not be created: %s", err.Error())
+	}
+
+	sub, err := c.NewSubscription(&processout.Subscription{
+		CustomerID: cust.ID,
+		PlanID:     plan.ID,
+	}).Create(processout.SubscriptionCreateParameters{Source: *tok.ID})
+	if err != nil {
+		t.Fatalf("The subscription could not be created: %s", err.Error())
+	}
+	if !*sub.Active || *sub.Amount != "9.99" || *sub.TokenID != *tok.ID {
+		t.Errorf("The subscription should be active and follow its plan")
+	}
+
+	sub.CancellationReason = processout.String("test")
+	sub, err = sub.Cancel()
+	if err != nil {
+		t.Fatalf("The subscription could not be canceled: %s", err.Error())
+	}
+	if !*sub.Canceled || *sub.Active || *sub.CancellationReason != "test" {
+		t.Errorf("The subscription should be canceled")
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	c := processout.New("proj_test", "wrong-secret")
+	c.BaseURL = srv.URL
+	_, err := c.NewInvoice().Find("iv_test000001")
+	if _, ok := err.(*errors.AuthenticationError); !ok {
+		t.Errorf("The request should have been rejected, got %v", err)
+	}
+}
+
+func TestCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "capture.json")
+	pay := func(c *processout.ProcessOut) (*processout.Transaction, error) {
+		iv, err := c.NewInvoice(&processout.Invoice{
+			Name:     processout.String("Test invoice"),
+			Amount:   processout.String("10.00"),
+			Currency: processout.String("USD"),
+			Metadata: &map[string]string{"card": "4242 4242 4242 4242"},
+		}).Create()
+		if err != nil {
+			return nil, err
+		}
+		return iv.Capture(SourceApproved)
+	}
+
+	srv := NewServer()
+	rec := RecordCassette(path)
+	rec.Transport = srv.Server.Client().Transport
+	c := srv.Client()
+	c.HTTPClient = rec.Client()
+	recorded, err := pay(c)
+	if err != nil {
+		t.Fatalf("The payment could not be recorded: %s", err.Error())
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("The cassette could not be saved: %s", err.Error())
+	}
+	srv.Close()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("The cassette could not be read: %s", err.Error())
+	}
+	for _, secret := range []string{"4242 4242 4242 4242", "Basic "} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("The cassette should not contain %q", secret)
+		}
+	}
+
+	cassette := NewCassette(t, path)
+	c.HTTPClient = cassette.Client()
+	replayed, err := pay(c)
+	if err != nil {
+		t.Fatalf("The payment could not be replayed: %s", err.Error())
+	}
+	if *replayed.ID != *recorded.ID || *replayed.Status != "completed" {
+		t.Errorf("The replayed transaction should match the recorded one")
+	}
+
+	_, err = c.NewInvoice().Find("iv_unknown")
+	var uerr *UnmatchedRequestError
+	if !stderrors.As(err, &uerr) || uerr.Request.Path != "/invoices/iv_unknown" {
+		t.Errorf("The unmatched request should have failed, got %v", err)
+	}
+	if len(cassette.Unmatched()) != 1 {
+		t.Errorf("The unmatched request should have been reported")
+	}
+	// The unmatched request was expected, it must not fail the test
+	cassette.unmatched = nil
+}
+
+func TestCassetteCard(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "card.json")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"card":{"id":"card_test","last_4_digits":"4242",`+
+			`"exp_month":12,"exp_year":2030,"raw_pan":4242424242424242}}`)
+	}))
+	defer srv.Close()
+
+	c := processout.New("project-id", "project-secret")
+	c.BaseURL = srv.URL
+	rec := RecordCassette(path)
+	rec.Transport = srv.Client().Transport
+	c.HTTPClient = rec.Client()
+	if _, err := c.NewCard().Find("card_test"); err != nil {
+		t.Fatalf("The card could not be recorded: %s", err.Error())
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("The cassette could not be saved: %s", err.Error())
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("The cassette could not be read: %s", err.Error())
+	}
+	for _, secret := range []string{"2030", "4242424242424242"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("The cassette should not contain %q", secret)
+		}
+	}
+
+	c.HTTPClient = NewCassette(t, path).Client()
+	card, err := c.NewCard().Find("card_test")
+	if err != nil {
+		t.Fatalf("The card could not be replayed: %s", err.Error())
+	}
+	if *card.Last4Digits != "4242" || *card.ExpMonth != 0 || *card.ExpYear != 0 {
+		t.Errorf("The replayed card should have its expiry date scrubbed, got %d/%d",
+			*card.ExpMonth, *card.ExpYear)
+	}
+}
+
+func TestCassetteGatewayRequest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gateway.json")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true,"transaction":{"id":"tr_test","status":"completed"}}`)
+	}))
+	defer srv.Close()
+
+	req := httptest.NewRequest("POST", "https://gateway.example.com/charges",
+		strings.NewReader(`{"card_number":"4242424242424242","cvc":"123"}`))
+	source := processout.NewGatewayRequest("gway_conf_test", req).String()
+	capture := func(c *processout.ProcessOut) (*processout.Transaction, error) {
+		return c.NewInvoice(&processout.Invoice{ID: processout.String("iv_test")}).Capture(source)
+	}
+
+	c := processout.New("project-id", "project-secret")
+	c.BaseURL = srv.URL
+	rec := RecordCassette(path)
+	rec.Transport = srv.Client().Transport
+	c.HTTPClient = rec.Client()
+	if _, err := capture(c); err != nil {
+		t.Fatalf("The capture could not be recorded: %s", err.Error())
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("The cassette could not be saved: %s", err.Error())
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("The cassette could not be read: %s", err.Error())
+	}
+	for _, secret := range []string{source, "4242424242424242"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("The cassette should not contain %q", secret)
+		}
+	}
+	if !strings.Contains(string(b), "gway_req_"+scrubbed) {
+		t.Errorf("The gateway request should have been scrubbed")
+	}
+
+	c.HTTPClient = NewCassette(t, path).Client()
+	if _, err := capture(c); err != nil {
+		t.Fatalf("The capture could not be replayed: %s", err.Error())
+	}
+}
@@ -0,0 +1,423 @@
+package processouttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/processout.v4"
+	"gopkg.in/processout.v4/errors"
+)
+
+// Payment sources triggering the outcomes of the payments made with them.
+// They can be used as the source of invoice authorizations and captures,
+// tokens and subscriptions. Any other card ID (card_*) is approved
+const (
+	SourceApproved          = "test-approved"
+	SourceDeclined          = "test-declined"
+	SourceInsufficientFunds = "test-insufficient-funds"
+	SourceFraud             = "test-fraud"
+	SourceThreeDS           = "test-three-d-s"
+)
+
+// Invoice amounts triggering the outcomes of the payments of the invoice,
+// whatever their source
+const (
+	AmountDeclined          = "1.01"
+	AmountInsufficientFunds = "1.02"
+	AmountFraud             = "1.03"
+	AmountThreeDS           = "1.04"
+	AmountGatewayTimeout    = "1.05"
+)
+
+// sourceOutcomes and amountOutcomes map the magic sources and amounts to
+// the error code of the payments made with them
+var (
+	sourceOutcomes = map[string]string{
+		SourceDeclined:          errors.CodeCardDeclined,
+		SourceInsufficientFunds: errors.CodeCardInsufficientFunds,
+		SourceFraud:             errors.CodeCardSuspectedFraud,
+		SourceThreeDS:           errors.CodeCardNeedsAuthentication,
+	}
+	amountOutcomes = map[string]string{
+		AmountDeclined:          errors.CodeCardDeclined,
+		AmountInsufficientFunds: errors.CodeCardInsufficientFunds,
+		AmountFraud:             errors.CodeCardSuspectedFraud,
+		AmountThreeDS:           errors.CodeCardNeedsAuthentication,
+		AmountGatewayTimeout:    errors.CodeGatewayTimeout,
+	}
+)
+
+// Server is a fake ProcessOut API keeping its resources in memory
+type Server struct {
+	*httptest.Server
+
+	// ProjectID and ProjectSecret are the credentials accepted by the
+	// server
+	ProjectID     string
+	ProjectSecret string
+	// Now returns the current date. By default, the date starts on
+	// 2020-01-01 and moves forward by one second each time it is read, so
+	// that the dates of the resources are deterministic
+	Now func() time.Time
+
+	mu       sync.Mutex
+	clock    time.Time
+	sequence map[string]int
+
+	invoices      *collection[processout.Invoice]
+	transactions  *collection[processout.Transaction]
+	refunds       *collection[processout.Refund]
+	customers     *collection[processout.Customer]
+	tokens        *collection[processout.Token]
+	subscriptions *collection[processout.Subscription]
+	plans         *collection[processout.Plan]
+	coupons       *collection[processout.Coupon]
+	events        *collection[processout.Event]
+
+	// sources maps the IDs of the tokens to the source they were created
+	// from
+	sources map[string]string
+	// authenticated lists the invoices whose customer went through 3-D
+	// Secure
+	authenticated map[string]bool
+}
+
+// NewServer starts a new fake ProcessOut API. It should be closed once done
+func NewServer() *Server {
+	s := &Server{
+		ProjectID:     "proj_test",
+		ProjectSecret: "key_test",
+		clock:         time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		sequence:      map[string]int{},
+		invoices:      newCollection[processout.Invoice](),
+		transactions:  newCollection[processout.Transaction](),
+		refunds:       newCollection[processout.Refund](),
+		customers:     newCollection[processout.Customer](),
+		tokens:        newCollection[processout.Token](),
+		subscriptions: newCollection[processout.Subscription](),
+		plans:         newCollection[processout.Plan](),
+		coupons:       newCollection[processout.Coupon](),
+		events:        newCollection[processout.Event](),
+		sources:       map[string]string{},
+		authenticated: map[string]bool{},
+	}
+
+	mux := http.NewServeMux()
+	s.routePayments(mux)
+	s.routeCustomers(mux)
+	s.routeSubscriptions(mux)
+	s.routeEvents(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fail(w, http.StatusNotFound, errors.CodeRequestNotFound,
+			"The route "+r.Method+" "+r.URL.Path+" is not implemented by the fake")
+	})
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Client returns a new ProcessOut client sending its requests to the server
+func (s *Server) Client() *processout.ProcessOut {
+	c := processout.New(s.ProjectID, s.ProjectSecret)
+	c.BaseURL = s.URL
+	c.HTTPClient = s.Server.Client()
+
+	return c
+}
+
+// handle registers the handler of the pattern. Handlers are called one at a
+// time, once the credentials of the request were checked
+func (s *Server) handle(mux *http.ServeMux, pattern string, h http.HandlerFunc) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != s.ProjectID || secret != s.ProjectSecret {
+			fail(w, http.StatusUnauthorized, errors.CodeRequestAuthentication,
+				"The project ID or secret is invalid")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		h(w, r)
+	})
+}
+
+// now returns the current date of the server
+func (s *Server) now() *time.Time {
+	if s.Now != nil {
+		t := s.Now()
+		return &t
+	}
+
+	s.clock = s.clock.Add(time.Second)
+	t := s.clock
+	return &t
+}
+
+// newID returns the next deterministic ID with the given prefix, such as
+// iv_test000001
+func (s *Server) newID(prefix string) *string {
+	s.sequence[prefix]++
+
+	return processout.String(fmt.Sprintf("%s_test%06d", prefix, s.sequence[prefix]))
+}
+
+// emit records a new event, with a snapshot of the resource as its data
+func (s *Server) emit(name, key string, v interface{}) {
+	data, _ := json.Marshal(map[string]interface{}{key: v})
+	ev := &processout.Event{
+		ID:        s.newID("ev"),
+		ProjectID: processout.String(s.ProjectID),
+		Name:      processout.String(name),
+		Data:      json.RawMessage(data),
+		Sandbox:   processout.Bool(true),
+		FiredAt:   s.now(),
+	}
+	s.events.add(*ev.ID, ev)
+}
+
+// collection stores the resources of a type in the order they were created
+type collection[T any] struct {
+	order []string
+	items map[string]*T
+}
+
+func newCollection[T any]() *collection[T] {
+	return &collection[T]{items: map[string]*T{}}
+}
+
+func (c *collection[T]) add(id string, v *T) {
+	if _, ok := c.items[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.items[id] = v
+}
+
+func (c *collection[T]) get(id string) (*T, bool) {
+	v, ok := c.items[id]
+	return v, ok
+}
+
+func (c *collection[T]) remove(id string) {
+	delete(c.items, id)
+	for i, o := range c.order {
+		if o == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// page returns the page of the resources kept by the filter requested by
+// the pagination parameters of the request, from the most recently created
+// one, and whether more resources follow in the direction of the pagination
+func (c *collection[T]) page(r *http.Request, keep func(*T) bool) ([]*T, bool) {
+	ids := []string{}
+	for i := len(c.order) - 1; i >= 0; i-- {
+		if keep == nil || keep(c.items[c.order[i]]) {
+			ids = append(ids, c.order[i])
+		}
+	}
+
+	q := r.URL.Query()
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	from, to := 0, len(ids)
+	for i, id := range ids {
+		if id == q.Get("start_after") {
+			from = i + 1
+		}
+		if id == q.Get("end_before") {
+			to = i
+		}
+	}
+
+	hasMore := false
+	if q.Get("end_before") != "" {
+		if to-from > limit {
+			from, hasMore = to-limit, true
+		}
+	} else if to-from > limit {
+		to, hasMore = from+limit, true
+	}
+
+	l := []*T{}
+	for _, id := range ids[from:to] {
+		l = append(l, c.items[id])
+	}
+	return l, hasMore
+}
+
+// reply writes a successful response containing the given resource
+func reply(w http.ResponseWriter, key string, v interface{}) {
+	payload := map[string]interface{}{"success": true}
+	if key != "" {
+		payload[key] = v
+	}
+	write(w, http.StatusOK, payload)
+}
+
+// replyList writes a successful response containing a page of resources
+func replyList(w http.ResponseWriter, key string, v interface{}, hasMore bool) {
+	write(w, http.StatusOK, map[string]interface{}{
+		"success":  true,
+		"has_more": hasMore,
+		key:        v,
+	})
+}
+
+// fail writes an error response
+func fail(w http.ResponseWriter, status int, code, message string) {
+	write(w, status, map[string]interface{}{
+		"success":    false,
+		"error_type": code,
+		"message":    message,
+	})
+}
+
+// failFields writes a validation error response listing the missing fields
+func failFields(w http.ResponseWriter, fields ...string) {
+	l := []map[string]string{}
+	for _, f := range fields {
+		l = append(l, map[string]string{
+			"field":      f,
+			"error_type": "request.validation.missing-field",
+			"message":    "The " + f + " field is required",
+		})
+	}
+	write(w, http.StatusBadRequest, map[string]interface{}{
+		"success":    false,
+		"error_type": errors.CodeRequestValidationError,
+		"message":    "Some fields are missing: " + strings.Join(fields, ", "),
+		"fields":     l,
+	})
+}
+
+// notFound writes the error response of an unknown resource
+func notFound(w http.ResponseWriter, resource string) {
+	fail(w, http.StatusNotFound, errors.CodeResourceNotFound, "The "+resource+" could not be found")
+}
+
+func write(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// decode decodes the JSON body of the request into v, and writes an error
+// response when it's invalid. The body can be decoded several times
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	b, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	if err == nil && len(b) > 0 {
+		err = json.Unmarshal(b, v)
+	}
+	if err != nil {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The request body is not valid JSON")
+		return false
+	}
+
+	return true
+}
+
+// merge updates the resource with the given fields of the JSON body of the
+// request, leaving the fields that are missing or null untouched
+func merge(w http.ResponseWriter, r *http.Request, v interface{}, fields ...string) bool {
+	body := map[string]json.RawMessage{}
+	if !decode(w, r, &body) {
+		return false
+	}
+
+	update := map[string]json.RawMessage{}
+	for _, f := range fields {
+		if raw, ok := body[f]; ok && string(raw) != "null" {
+			update[f] = raw
+		}
+	}
+	b, _ := json.Marshal(update)
+	if err := json.Unmarshal(b, v); err != nil {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError, err.Error())
+		return false
+	}
+
+	return true
+}
+
+// missing returns the names of the fields that are nil
+func missing(fields map[string]interface{}) []string {
+	l := []string{}
+	for name, v := range fields {
+		if isNil(v) {
+			l = append(l, name)
+		}
+	}
+	sort.Strings(l)
+
+	return l
+}
+
+func isNil(v interface{}) bool {
+	switch v := v.(type) {
+	case *string:
+		return v == nil || *v == ""
+	case *time.Time:
+		return v == nil
+	case nil:
+		return true
+	}
+
+	return false
+}
+
+// parseAmount parses a decimal amount
+func parseAmount(s *string) (*big.Rat, bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(*s)
+}
+
+// formatAmount formats the amount with as many decimals as the reference
+// amount, so that the amounts of a resource share their precision
+func formatAmount(r *big.Rat, ref *string) *string {
+	prec := 0
+	if ref != nil {
+		if i := strings.Index(*ref, "."); i >= 0 {
+			prec = len(*ref) - i - 1
+		}
+	}
+
+	return processout.String(r.FloatString(prec))
+}
+
+// rawAmount reads an amount sent either as a JSON string or number
+func rawAmount(raw json.RawMessage) *string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return &s
+	}
+	s = string(raw)
+	return &s
+}
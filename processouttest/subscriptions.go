@@ -0,0 +1,407 @@
+package processouttest
+
+import (
+	"net/http"
+	"time"
+
+	"gopkg.in/processout.v4"
+	"gopkg.in/processout.v4/errors"
+)
+
+func (s *Server) routeSubscriptions(mux *http.ServeMux) {
+	s.handle(mux, "GET /plans", s.listPlans)
+	s.handle(mux, "POST /plans", s.createPlan)
+	s.handle(mux, "GET /plans/{id}", s.findPlan)
+	s.handle(mux, "PUT /plans/{id}", s.savePlan)
+	s.handle(mux, "DELETE /plans/{id}", s.endPlan)
+
+	s.handle(mux, "GET /coupons", s.listCoupons)
+	s.handle(mux, "POST /coupons", s.createCoupon)
+	s.handle(mux, "GET /coupons/{id}", s.findCoupon)
+	s.handle(mux, "PUT /coupons/{id}", s.saveCoupon)
+	s.handle(mux, "DELETE /coupons/{id}", s.deleteCoupon)
+
+	s.handle(mux, "GET /subscriptions", s.listSubscriptions)
+	s.handle(mux, "POST /subscriptions", s.createSubscription)
+	s.handle(mux, "GET /subscriptions/{id}", s.findSubscription)
+	s.handle(mux, "PUT /subscriptions/{id}", s.saveSubscription)
+	s.handle(mux, "DELETE /subscriptions/{id}", s.cancelSubscription)
+	s.handle(mux, "GET /subscriptions/{id}/customers", s.findSubscriptionCustomer)
+	s.handle(mux, "GET /subscriptions/{id}/transactions", s.listSubscriptionTransactions)
+}
+
+func (s *Server) listPlans(w http.ResponseWriter, r *http.Request) {
+	l, hasMore := s.plans.page(r, nil)
+	replyList(w, "plans", l, hasMore)
+}
+
+func (s *Server) createPlan(w http.ResponseWriter, r *http.Request) {
+	plan := &processout.Plan{}
+	if !decode(w, r, plan) {
+		return
+	}
+	if m := missing(map[string]interface{}{
+		"name":     plan.Name,
+		"amount":   plan.Amount,
+		"currency": plan.Currency,
+		"interval": plan.Interval,
+	}); len(m) > 0 {
+		failFields(w, m...)
+		return
+	}
+	if plan.ID == nil || *plan.ID == "" {
+		plan.ID = s.newID("plan")
+	} else if _, ok := s.plans.get(*plan.ID); ok {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"A plan with the ID "+*plan.ID+" already exists")
+		return
+	}
+
+	plan.ProjectID = processout.String(s.ProjectID)
+	plan.Sandbox = processout.Bool(true)
+	plan.CreatedAt = s.now()
+	s.plans.add(*plan.ID, plan)
+
+	s.emit("plan.created", "plan", plan)
+	reply(w, "plan", plan)
+}
+
+func (s *Server) findPlan(w http.ResponseWriter, r *http.Request) {
+	plan, ok := s.plans.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "plan")
+		return
+	}
+
+	reply(w, "plan", plan)
+}
+
+func (s *Server) savePlan(w http.ResponseWriter, r *http.Request) {
+	plan, ok := s.plans.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "plan")
+		return
+	}
+	if !merge(w, r, plan, "name", "trial_period", "metadata", "return_url", "cancel_url") {
+		return
+	}
+
+	reply(w, "plan", plan)
+}
+
+func (s *Server) endPlan(w http.ResponseWriter, r *http.Request) {
+	plan, ok := s.plans.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "plan")
+		return
+	}
+
+	s.plans.remove(*plan.ID)
+	reply(w, "", nil)
+}
+
+func (s *Server) listCoupons(w http.ResponseWriter, r *http.Request) {
+	l, hasMore := s.coupons.page(r, nil)
+	replyList(w, "coupons", l, hasMore)
+}
+
+func (s *Server) createCoupon(w http.ResponseWriter, r *http.Request) {
+	coupon := &processout.Coupon{}
+	if !decode(w, r, coupon) {
+		return
+	}
+	switch {
+	case coupon.AmountOff == nil && coupon.PercentOff == nil:
+		failFields(w, "amount_off", "percent_off")
+		return
+	case coupon.AmountOff != nil && coupon.Currency == nil:
+		failFields(w, "currency")
+		return
+	}
+	if coupon.ID == nil || *coupon.ID == "" {
+		coupon.ID = s.newID("coupon")
+	} else if _, ok := s.coupons.get(*coupon.ID); ok {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"A coupon with the ID "+*coupon.ID+" already exists")
+		return
+	}
+
+	redeemed := 0
+	coupon.ProjectID = processout.String(s.ProjectID)
+	coupon.RedeemedNumber = &redeemed
+	coupon.Sandbox = processout.Bool(true)
+	coupon.CreatedAt = s.now()
+	s.coupons.add(*coupon.ID, coupon)
+
+	s.emit("coupon.created", "coupon", coupon)
+	reply(w, "coupon", coupon)
+}
+
+func (s *Server) findCoupon(w http.ResponseWriter, r *http.Request) {
+	coupon, ok := s.coupons.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "coupon")
+		return
+	}
+
+	reply(w, "coupon", coupon)
+}
+
+func (s *Server) saveCoupon(w http.ResponseWriter, r *http.Request) {
+	coupon, ok := s.coupons.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "coupon")
+		return
+	}
+	if !merge(w, r, coupon, "metadata") {
+		return
+	}
+
+	reply(w, "coupon", coupon)
+}
+
+func (s *Server) deleteCoupon(w http.ResponseWriter, r *http.Request) {
+	coupon, ok := s.coupons.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "coupon")
+		return
+	}
+
+	s.coupons.remove(*coupon.ID)
+	reply(w, "", nil)
+}
+
+// subscriptionBody holds the fields of the subscription requests that
+// aren't fields of the subscription
+type subscriptionBody struct {
+	Source             string     `json:"source"`
+	CouponID           string     `json:"coupon_id"`
+	CancelAt           *time.Time `json:"cancel_at"`
+	CancellationReason *string    `json:"cancellation_reason"`
+	CancelAtEnd        *bool      `json:"cancel_at_end"`
+}
+
+func (s *Server) listSubscriptions(w http.ResponseWriter, r *http.Request) {
+	l, hasMore := s.subscriptions.page(r, nil)
+	replyList(w, "subscriptions", l, hasMore)
+}
+
+func (s *Server) createSubscription(w http.ResponseWriter, r *http.Request) {
+	sub := &processout.Subscription{}
+	body := subscriptionBody{}
+	if !decode(w, r, &body) || !merge(w, r, sub, "plan_id", "cancel_at",
+		"name", "amount", "currency", "metadata", "interval", "trial_end_at",
+		"customer_id", "return_url", "cancel_url") {
+		return
+	}
+	if sub.CustomerID == nil {
+		failFields(w, "customer_id")
+		return
+	}
+	cust, ok := s.customers.get(*sub.CustomerID)
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+	if sub.PlanID != nil && !s.applyPlan(w, sub) {
+		return
+	}
+	if m := missing(map[string]interface{}{
+		"amount":   sub.Amount,
+		"currency": sub.Currency,
+		"interval": sub.Interval,
+	}); len(m) > 0 {
+		failFields(w, m...)
+		return
+	}
+	if body.CouponID != "" && !s.redeemCoupon(w, body.CouponID) {
+		return
+	}
+
+	now := s.now()
+	sub.Activated = processout.Bool(false)
+	if body.Source != "" && !s.activateSubscription(w, cust, sub, body.Source, now) {
+		return
+	}
+
+	sub.ID = s.newID("sub")
+	sub.ProjectID = processout.String(s.ProjectID)
+	sub.Active = processout.Bool(*sub.Activated || (sub.TrialEndAt != nil && sub.TrialEndAt.After(*now)))
+	sub.Canceled = processout.Bool(false)
+	sub.PendingCancellation = processout.Bool(false)
+	sub.Sandbox = processout.Bool(true)
+	sub.CreatedAt = now
+	s.subscriptions.add(*sub.ID, sub)
+
+	s.emit("subscription.created", "subscription", sub)
+	reply(w, "subscription", sub)
+}
+
+// applyPlan sets the amount, currency and interval of the subscription to
+// the ones of its plan
+func (s *Server) applyPlan(w http.ResponseWriter, sub *processout.Subscription) bool {
+	plan, ok := s.plans.get(*sub.PlanID)
+	if !ok {
+		notFound(w, "plan")
+		return false
+	}
+
+	sub.Amount = plan.Amount
+	sub.Currency = plan.Currency
+	sub.Interval = plan.Interval
+	if sub.Name == nil {
+		sub.Name = plan.Name
+	}
+	return true
+}
+
+// redeemCoupon redeems the coupon, unless it expired or was redeemed too
+// many times
+func (s *Server) redeemCoupon(w http.ResponseWriter, couponID string) bool {
+	coupon, ok := s.coupons.get(couponID)
+	if !ok {
+		notFound(w, "coupon")
+		return false
+	}
+	if coupon.MaxRedemptions != nil && *coupon.MaxRedemptions > 0 &&
+		*coupon.RedeemedNumber >= *coupon.MaxRedemptions {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The coupon was redeemed too many times")
+		return false
+	}
+
+	*coupon.RedeemedNumber++
+	return true
+}
+
+// activateSubscription charges the subscription using the source, which
+// is either a token of the customer or a card. It writes the error response
+// and returns false if the payment was declined
+func (s *Server) activateSubscription(w http.ResponseWriter, cust *processout.Customer, sub *processout.Subscription, src string, now *time.Time) bool {
+	source, tokenID, ok := s.source(src)
+	if !ok || (tokenID != "" && !s.customerToken(cust, tokenID)) {
+		notFound(w, "payment source")
+		return false
+	}
+	if code := outcome(source, sub.Amount); code != "" {
+		fail(w, http.StatusPaymentRequired, code, "The payment of the subscription was declined")
+		return false
+	}
+
+	if tokenID != "" {
+		sub.TokenID = processout.String(tokenID)
+	}
+	if !*sub.Activated {
+		sub.Activated = processout.Bool(true)
+		sub.ActivatedAt = now
+	}
+	sub.Active = processout.Bool(true)
+	return true
+}
+
+func (s *Server) findSubscription(w http.ResponseWriter, r *http.Request) {
+	sub, ok := s.subscriptions.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "subscription")
+		return
+	}
+
+	reply(w, "subscription", sub)
+}
+
+func (s *Server) saveSubscription(w http.ResponseWriter, r *http.Request) {
+	sub, ok := s.subscriptions.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "subscription")
+		return
+	}
+	if *sub.Canceled {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The subscription was canceled")
+		return
+	}
+
+	update := *sub
+	body := subscriptionBody{}
+	if !decode(w, r, &body) || !merge(w, r, &update, "plan_id", "name",
+		"amount", "interval", "trial_end_at", "metadata") {
+		return
+	}
+	if update.PlanID != nil && (sub.PlanID == nil || *update.PlanID != *sub.PlanID) &&
+		!s.applyPlan(w, &update) {
+		return
+	}
+	if body.CouponID != "" && !s.redeemCoupon(w, body.CouponID) {
+		return
+	}
+	cust, _ := s.customers.get(*sub.CustomerID)
+	if body.Source != "" && cust != nil && !s.activateSubscription(w, cust, &update, body.Source, s.now()) {
+		return
+	}
+
+	*sub = update
+	s.emit("subscription.updated", "subscription", sub)
+	reply(w, "subscription", sub)
+}
+
+func (s *Server) cancelSubscription(w http.ResponseWriter, r *http.Request) {
+	sub, ok := s.subscriptions.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "subscription")
+		return
+	}
+	body := subscriptionBody{}
+	if !decode(w, r, &body) {
+		return
+	}
+	if *sub.Canceled {
+		fail(w, http.StatusBadRequest, errors.CodeRequestValidationError,
+			"The subscription was already canceled")
+		return
+	}
+
+	now := s.now()
+	sub.CancellationReason = body.CancellationReason
+	if (body.CancelAtEnd != nil && *body.CancelAtEnd) || (body.CancelAt != nil && body.CancelAt.After(*now)) {
+		sub.PendingCancellation = processout.Bool(true)
+		sub.CancelAt = body.CancelAt
+		s.emit("subscription.updated", "subscription", sub)
+		reply(w, "subscription", sub)
+		return
+	}
+
+	sub.Canceled = processout.Bool(true)
+	sub.Active = processout.Bool(false)
+	sub.PendingCancellation = processout.Bool(false)
+	sub.CancelAt = now
+	s.emit("subscription.canceled", "subscription", sub)
+	reply(w, "subscription", sub)
+}
+
+func (s *Server) findSubscriptionCustomer(w http.ResponseWriter, r *http.Request) {
+	sub, ok := s.subscriptions.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "subscription")
+		return
+	}
+	cust, ok := s.customers.get(*sub.CustomerID)
+	if !ok {
+		notFound(w, "customer")
+		return
+	}
+
+	reply(w, "customer", cust)
+}
+
+func (s *Server) listSubscriptionTransactions(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.subscriptions.get(id); !ok {
+		notFound(w, "subscription")
+		return
+	}
+
+	l, hasMore := s.transactions.page(r, func(tr *processout.Transaction) bool {
+		return tr.SubscriptionID != nil && *tr.SubscriptionID == id
+	})
+	replyList(w, "transactions", l, hasMore)
+}
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -96,6 +97,11 @@ type ProductCreateInvoiceParameters struct {
 
 // CreateInvoice allows you to create a new invoice from the product.
 func (s Product) CreateInvoice(options ...ProductCreateInvoiceParameters) (*Invoice, error) {
+	return s.CreateInvoiceWithContext(context.Background(), options...)
+}
+
+// CreateInvoiceWithContext allows you to create a new invoice from the product.
+func (s Product) CreateInvoiceWithContext(ctx context.Context, options ...ProductCreateInvoiceParameters) (*Invoice, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProduct() method to create a new Product object")
 	}
@@ -133,9 +139,10 @@ func (s Product) CreateInvoice(options ...ProductCreateInvoiceParameters) (*Invo
 
 	path := "/products/" + url.QueryEscape(*s.ID) + "/invoices"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -143,23 +150,31 @@ func (s Product) CreateInvoice(options ...ProductCreateInvoiceParameters) (*Invo
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Product",
+		Method:     "CreateInvoice",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -177,6 +192,11 @@ type ProductAllParameters struct {
 
 // All allows you to get all the products.
 func (s Product) All(options ...ProductAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the products.
+func (s Product) AllWithContext(ctx context.Context, options ...ProductAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProduct() method to create a new Product object")
 	}
@@ -215,9 +235,10 @@ func (s Product) All(options ...ProductAllParameters) (*Iterator, error) {
 
 	path := "/products"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -225,23 +246,30 @@ func (s Product) All(options ...ProductAllParameters) (*Iterator, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Product",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -250,31 +278,52 @@ func (s Product) All(options ...ProductAllParameters) (*Iterator, error) {
 	for _, o := range payload.Products {
 		productsList = append(productsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	productsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Product",
+			Method:   "All",
+		},
 		data:    productsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"products"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Product `json:"products"`
+				HasMore bool       `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return productsIterator, nil
 }
 
+// AllTyped allows you to get all the products, through an iterator of *Product.
+func (s Product) AllTyped(options ...ProductAllParameters) (*TypedIterator[*Product], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the products, through an iterator of *Product.
+func (s Product) AllTypedWithContext(ctx context.Context, options ...ProductAllParameters) (*TypedIterator[*Product], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Product]{Iterator: it}, nil
+}
+
 // ProductCreateParameters is the structure representing the
 // additional parameters used to call Product.Create
 type ProductCreateParameters struct {
@@ -284,6 +333,11 @@ type ProductCreateParameters struct {
 
 // Create allows you to create a new product.
 func (s Product) Create(options ...ProductCreateParameters) (*Product, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new product.
+func (s Product) CreateWithContext(ctx context.Context, options ...ProductCreateParameters) (*Product, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProduct() method to create a new Product object")
 	}
@@ -299,6 +353,13 @@ func (s Product) Create(options ...ProductCreateParameters) (*Product, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Product)
+	if err := s.client.validate(Call{
+		Resource: "Product",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Product *Product `json:"product"`
@@ -333,9 +394,10 @@ func (s Product) Create(options ...ProductCreateParameters) (*Product, error) {
 
 	path := "/products"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -343,23 +405,30 @@ func (s Product) Create(options ...ProductCreateParameters) (*Product, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Product",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -377,6 +446,11 @@ type ProductFindParameters struct {
 
 // Find allows you to find a product by its ID.
 func (s Product) Find(productID string, options ...ProductFindParameters) (*Product, error) {
+	return s.FindWithContext(context.Background(), productID, options...)
+}
+
+// FindWithContext allows you to find a product by its ID.
+func (s Product) FindWithContext(ctx context.Context, productID string, options ...ProductFindParameters) (*Product, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProduct() method to create a new Product object")
 	}
@@ -414,9 +488,10 @@ func (s Product) Find(productID string, options ...ProductFindParameters) (*Prod
 
 	path := "/products/" + url.QueryEscape(productID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -424,23 +499,31 @@ func (s Product) Find(productID string, options ...ProductFindParameters) (*Prod
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Product",
+		Method:     "Find",
+		ResourceID: productID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -458,6 +541,11 @@ type ProductSaveParameters struct {
 
 // Save allows you to save the updated product attributes.
 func (s Product) Save(options ...ProductSaveParameters) (*Product, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated product attributes.
+func (s Product) SaveWithContext(ctx context.Context, options ...ProductSaveParameters) (*Product, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProduct() method to create a new Product object")
 	}
@@ -473,6 +561,14 @@ func (s Product) Save(options ...ProductSaveParameters) (*Product, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Product)
+	if err := s.client.validate(Call{
+		Resource:   "Product",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Product *Product `json:"product"`
@@ -507,9 +603,10 @@ func (s Product) Save(options ...ProductSaveParameters) (*Product, error) {
 
 	path := "/products/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -517,23 +614,31 @@ func (s Product) Save(options ...ProductSaveParameters) (*Product, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Product",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -551,6 +656,11 @@ type ProductDeleteParameters struct {
 
 // Delete allows you to delete the product.
 func (s Product) Delete(options ...ProductDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete the product.
+func (s Product) DeleteWithContext(ctx context.Context, options ...ProductDeleteParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewProduct() method to create a new Product object")
 	}
@@ -587,9 +697,10 @@ func (s Product) Delete(options ...ProductDeleteParameters) error {
 
 	path := "/products/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -597,23 +708,31 @@ func (s Product) Delete(options ...ProductDeleteParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Product",
+		Method:     "Delete",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -621,6 +740,17 @@ func (s Product) Delete(options ...ProductDeleteParameters) error {
 	return nil
 }
 
+// AmountMoney returns the amount of the product in its currency
+func (s *Product) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, s.Currency)
+}
+
+// Validate returns a validation error if the currency of the product isn't
+// an ISO 4217 currency. It implements Validator
+func (s *Product) Validate() error {
+	return validateCurrency("currency", s.Currency)
+}
+
 // dummyProduct is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -96,6 +97,11 @@ type ProjectFetchParameters struct {
 
 // Fetch allows you to fetch the current project information.
 func (s Project) Fetch(options ...ProjectFetchParameters) (*Project, error) {
+	return s.FetchWithContext(context.Background(), options...)
+}
+
+// FetchWithContext allows you to fetch the current project information.
+func (s Project) FetchWithContext(ctx context.Context, options ...ProjectFetchParameters) (*Project, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProject() method to create a new Project object")
 	}
@@ -133,9 +139,10 @@ func (s Project) Fetch(options ...ProjectFetchParameters) (*Project, error) {
 
 	path := "/projects/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -143,23 +150,31 @@ func (s Project) Fetch(options ...ProjectFetchParameters) (*Project, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Project",
+		Method:     "Fetch",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -177,6 +192,11 @@ type ProjectSaveParameters struct {
 
 // Save allows you to save the updated project's attributes.
 func (s Project) Save(options ...ProjectSaveParameters) (*Project, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated project's attributes.
+func (s Project) SaveWithContext(ctx context.Context, options ...ProjectSaveParameters) (*Project, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProject() method to create a new Project object")
 	}
@@ -192,6 +212,14 @@ func (s Project) Save(options ...ProjectSaveParameters) (*Project, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Project)
+	if err := s.client.validate(Call{
+		Resource:   "Project",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Project *Project `json:"project"`
@@ -214,9 +242,10 @@ func (s Project) Save(options ...ProjectSaveParameters) (*Project, error) {
 
 	path := "/projects/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -224,23 +253,31 @@ func (s Project) Save(options ...ProjectSaveParameters) (*Project, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Project",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -258,6 +295,11 @@ type ProjectDeleteParameters struct {
 
 // Delete allows you to delete the project. Be careful! Executing this request will prevent any further interaction with the API that uses this project.
 func (s Project) Delete(options ...ProjectDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete the project. Be careful! Executing this request will prevent any further interaction with the API that uses this project.
+func (s Project) DeleteWithContext(ctx context.Context, options ...ProjectDeleteParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewProject() method to create a new Project object")
 	}
@@ -294,9 +336,10 @@ func (s Project) Delete(options ...ProjectDeleteParameters) error {
 
 	path := "/projects/{project_id}"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -304,23 +347,30 @@ func (s Project) Delete(options ...ProjectDeleteParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Project",
+		Method:   "Delete",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -337,6 +387,11 @@ type ProjectFetchSupervisedParameters struct {
 
 // FetchSupervised allows you to get all the supervised projects.
 func (s Project) FetchSupervised(options ...ProjectFetchSupervisedParameters) (*Iterator, error) {
+	return s.FetchSupervisedWithContext(context.Background(), options...)
+}
+
+// FetchSupervisedWithContext allows you to get all the supervised projects.
+func (s Project) FetchSupervisedWithContext(ctx context.Context, options ...ProjectFetchSupervisedParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProject() method to create a new Project object")
 	}
@@ -375,9 +430,10 @@ func (s Project) FetchSupervised(options ...ProjectFetchSupervisedParameters) (*
 
 	path := "/supervised-projects"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -385,23 +441,30 @@ func (s Project) FetchSupervised(options ...ProjectFetchSupervisedParameters) (*
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Project",
+		Method:   "FetchSupervised",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -410,31 +473,52 @@ func (s Project) FetchSupervised(options ...ProjectFetchSupervisedParameters) (*
 	for _, o := range payload.Projects {
 		projectsList = append(projectsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	projectsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Project",
+			Method:   "FetchSupervised",
+		},
 		data:    projectsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"projects"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Project `json:"projects"`
+				HasMore bool       `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return projectsIterator, nil
 }
 
+// FetchSupervisedTyped allows you to get all the supervised projects, through an iterator of *Project.
+func (s Project) FetchSupervisedTyped(options ...ProjectFetchSupervisedParameters) (*TypedIterator[*Project], error) {
+	return s.FetchSupervisedTypedWithContext(context.Background(), options...)
+}
+
+// FetchSupervisedTypedWithContext allows you to get all the supervised projects, through an iterator of *Project.
+func (s Project) FetchSupervisedTypedWithContext(ctx context.Context, options ...ProjectFetchSupervisedParameters) (*TypedIterator[*Project], error) {
+	it, err := s.FetchSupervisedWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Project]{Iterator: it}, nil
+}
+
 // ProjectCreateSupervisedParameters is the structure representing the
 // additional parameters used to call Project.CreateSupervised
 type ProjectCreateSupervisedParameters struct {
@@ -445,6 +529,11 @@ type ProjectCreateSupervisedParameters struct {
 
 // CreateSupervised allows you to create a new supervised project.
 func (s Project) CreateSupervised(options ...ProjectCreateSupervisedParameters) (*Project, error) {
+	return s.CreateSupervisedWithContext(context.Background(), options...)
+}
+
+// CreateSupervisedWithContext allows you to create a new supervised project.
+func (s Project) CreateSupervisedWithContext(ctx context.Context, options ...ProjectCreateSupervisedParameters) (*Project, error) {
 	if s.client == nil {
 		panic("Please use the client.NewProject() method to create a new Project object")
 	}
@@ -460,6 +549,13 @@ func (s Project) CreateSupervised(options ...ProjectCreateSupervisedParameters)
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Project)
+	if err := s.client.validate(Call{
+		Resource: "Project",
+		Method:   "CreateSupervised",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Project *Project `json:"project"`
@@ -492,9 +588,10 @@ func (s Project) CreateSupervised(options ...ProjectCreateSupervisedParameters)
 
 	path := "/supervised-projects"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -502,23 +599,30 @@ func (s Project) CreateSupervised(options ...ProjectCreateSupervisedParameters)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Project",
+		Method:   "CreateSupervised",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -527,6 +631,12 @@ func (s Project) CreateSupervised(options ...ProjectCreateSupervisedParameters)
 	return payload.Project, nil
 }
 
+// Validate returns a validation error if the default currency of the project isn't
+// an ISO 4217 currency. It implements Validator
+func (s *Project) Validate() error {
+	return validateCurrency("default_currency", s.DefaultCurrency)
+}
+
 // dummyProject is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -90,6 +91,11 @@ type RefundFetchTransactionRefundsParameters struct {
 
 // FetchTransactionRefunds allows you to get the transaction's refunds.
 func (s Refund) FetchTransactionRefunds(transactionID string, options ...RefundFetchTransactionRefundsParameters) (*Iterator, error) {
+	return s.FetchTransactionRefundsWithContext(context.Background(), transactionID, options...)
+}
+
+// FetchTransactionRefundsWithContext allows you to get the transaction's refunds.
+func (s Refund) FetchTransactionRefundsWithContext(ctx context.Context, transactionID string, options ...RefundFetchTransactionRefundsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewRefund() method to create a new Refund object")
 	}
@@ -128,9 +134,10 @@ func (s Refund) FetchTransactionRefunds(transactionID string, options ...RefundF
 
 	path := "/transactions/" + url.QueryEscape(transactionID) + "/refunds"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -138,23 +145,30 @@ func (s Refund) FetchTransactionRefunds(transactionID string, options ...RefundF
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Refund",
+		Method:   "FetchTransactionRefunds",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -163,31 +177,52 @@ func (s Refund) FetchTransactionRefunds(transactionID string, options ...RefundF
 	for _, o := range payload.Refunds {
 		refundsList = append(refundsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	refundsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Refund",
+			Method:   "FetchTransactionRefunds",
+		},
 		data:    refundsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"refunds"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Refund `json:"refunds"`
+				HasMore bool      `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return refundsIterator, nil
 }
 
+// FetchTransactionRefundsTyped allows you to get the transaction's refunds, through an iterator of *Refund.
+func (s Refund) FetchTransactionRefundsTyped(transactionID string, options ...RefundFetchTransactionRefundsParameters) (*TypedIterator[*Refund], error) {
+	return s.FetchTransactionRefundsTypedWithContext(context.Background(), transactionID, options...)
+}
+
+// FetchTransactionRefundsTypedWithContext allows you to get the transaction's refunds, through an iterator of *Refund.
+func (s Refund) FetchTransactionRefundsTypedWithContext(ctx context.Context, transactionID string, options ...RefundFetchTransactionRefundsParameters) (*TypedIterator[*Refund], error) {
+	it, err := s.FetchTransactionRefundsWithContext(ctx, transactionID, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Refund]{Iterator: it}, nil
+}
+
 // RefundFindParameters is the structure representing the
 // additional parameters used to call Refund.Find
 type RefundFindParameters struct {
@@ -197,6 +232,11 @@ type RefundFindParameters struct {
 
 // Find allows you to find a transaction's refund by its ID.
 func (s Refund) Find(transactionID, refundID string, options ...RefundFindParameters) (*Refund, error) {
+	return s.FindWithContext(context.Background(), transactionID, refundID, options...)
+}
+
+// FindWithContext allows you to find a transaction's refund by its ID.
+func (s Refund) FindWithContext(ctx context.Context, transactionID, refundID string, options ...RefundFindParameters) (*Refund, error) {
 	if s.client == nil {
 		panic("Please use the client.NewRefund() method to create a new Refund object")
 	}
@@ -234,9 +274,10 @@ func (s Refund) Find(transactionID, refundID string, options ...RefundFindParame
 
 	path := "/transactions/" + url.QueryEscape(transactionID) + "/refunds/" + url.QueryEscape(refundID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -244,23 +285,31 @@ func (s Refund) Find(transactionID, refundID string, options ...RefundFindParame
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Refund",
+		Method:     "Find",
+		ResourceID: refundID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -278,6 +327,11 @@ type RefundCreateParameters struct {
 
 // Create allows you to create a refund for a transaction.
 func (s Refund) Create(options ...RefundCreateParameters) error {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a refund for a transaction.
+func (s Refund) CreateWithContext(ctx context.Context, options ...RefundCreateParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewRefund() method to create a new Refund object")
 	}
@@ -293,6 +347,13 @@ func (s Refund) Create(options ...RefundCreateParameters) error {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Refund)
+	if err := s.client.validate(Call{
+		Resource: "Refund",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return err
+	}
 
 	type Response struct {
 		HasMore bool   `json:"has_more"`
@@ -322,9 +383,10 @@ func (s Refund) Create(options ...RefundCreateParameters) error {
 
 	path := "/transactions/" + url.QueryEscape(*s.TransactionID) + "/refunds"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -332,23 +394,30 @@ func (s Refund) Create(options ...RefundCreateParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Refund",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -356,6 +425,17 @@ func (s Refund) Create(options ...RefundCreateParameters) error {
 	return nil
 }
 
+// AmountMoney returns the amount of the refund in the currency of its
+// transaction, which is empty unless Transaction was expanded
+func (s *Refund) AmountMoney() (Money, error) {
+	var currency *string
+	if s.Transaction != nil {
+		currency = s.Transaction.Currency
+	}
+
+	return moneyOf(s.Amount, currency)
+}
+
 // dummyRefund is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
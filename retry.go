@@ -0,0 +1,209 @@
+package processout
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gopkg.in/processout.v4/errors"
+)
+
+var (
+	// DefaultRetryPolicy is a sensible retry policy that can be set on
+	// ProcessOut clients. Clients don't retry requests unless a policy is set
+	DefaultRetryPolicy = &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+		Jitter:      0.5,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+)
+
+// RetryPolicy describes how requests failing because of a network error or
+// a retryable status code are retried by a ProcessOut client. When a policy
+// allows more than one attempt, POST and PUT requests sent without an
+// idempotency key are given a generated one, reused across all the attempts
+// so that a retried request can never be applied twice
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a single
+	// request, including the first one
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. It is doubled after
+	// every attempt
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including the delays
+	// requested through the Retry-After header. Zero means no cap
+	MaxBackoff time.Duration
+	// Jitter is the fraction, between 0 and 1, of each delay that is
+	// randomized to avoid retrying in lockstep
+	Jitter float64
+	// RetryableStatuses is the list of HTTP status codes that are retried
+	RetryableStatuses []int
+}
+
+// attempts returns the maximum number of attempts allowed by the policy
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// retryable returns whether or not the outcome of an attempt should be
+// retried
+func (p *RetryPolicy) retryable(ctx context.Context, res *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return errors.IsRetryable(errors.NewNetworkError(err))
+	}
+	for _, s := range p.RetryableStatuses {
+		if res.StatusCode == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay to wait for before the given attempt number is
+// retried
+func (p *RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if d, ok := retryAfter(res); ok {
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			d = p.MaxBackoff
+		}
+		return d
+	}
+
+	d := p.MinBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			d = p.MaxBackoff
+			break
+		}
+	}
+	if p.Jitter > 0 {
+		j := time.Duration(float64(d) * p.Jitter)
+		if j > 0 {
+			d = d - j + time.Duration(mathrand.Int63n(int64(j)))
+		}
+	}
+
+	return d
+}
+
+// retryAfter parses the Retry-After header of the response, if any
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	h := res.Header.Get("Retry-After")
+	if h == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(h); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(h); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// newIdempotencyKey generates a random idempotency key
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// do sends the request of the given call through the client middlewares
+// and HTTPClient, retrying it according to the client RetryPolicy and
+// throttling it with the client Limiter
+func (c *ProcessOut) do(req *http.Request, call Call) (*http.Response, error) {
+	policy := c.RetryPolicy
+	attempts := policy.attempts()
+	if attempts > 1 && (req.Method == "POST" || req.Method == "PUT") &&
+		req.Header.Get("Idempotency-Key") == "" {
+		req.Header.Set("Idempotency-Key", newIdempotencyKey())
+	}
+
+	if err := c.authenticate(req); err != nil {
+		return nil, err
+	}
+
+	rt := c.roundTripper()
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 {
+			r = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				r.Body = body
+			}
+		}
+
+		attemptCall := call
+		attemptCall.Attempt = attempt
+		release, err := c.Limiter.wait(ctx, &attemptCall)
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		res, err := rt(&attemptCall, r)
+		if res == nil && err == nil {
+			err = errors.NewNetworkError(fmt.Errorf(
+				"processout: the middlewares returned neither a response nor an error"))
+		}
+		releaseBody(res, release)
+		c.Limiter.observe(res)
+		c.recordMetrics(&attemptCall, start, res, err)
+		c.logCall(&attemptCall, r, start, res, err)
+		if attempt >= attempts || !policy.retryable(ctx, res, err) {
+			return res, err
+		}
+
+		delay := policy.backoff(attempt, res)
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
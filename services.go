@@ -0,0 +1,1122 @@
+package processout
+
+import (
+	"context"
+)
+
+// Client is the interface of the ProcessOut client, giving access to the
+// services of each resource. It is implemented by *ProcessOut, and allows
+// mocking the API in the code using the client
+type Client interface {
+	Activities(prefill ...*Activity) ActivityService
+	Addons(prefill ...*Addon) AddonService
+	APIRequests(prefill ...*APIRequest) APIRequestService
+	Cards(prefill ...*Card) CardService
+	CardInformation(prefill ...*CardInformation) CardInformationService
+	Coupons(prefill ...*Coupon) CouponService
+	Customers(prefill ...*Customer) CustomerService
+	Tokens(prefill ...*Token) TokenService
+	Discounts(prefill ...*Discount) DiscountService
+	Events(prefill ...*Event) EventService
+	Gateways(prefill ...*Gateway) GatewayService
+	GatewayConfigurations(prefill ...*GatewayConfiguration) GatewayConfigurationService
+	Invoices(prefill ...*Invoice) InvoiceService
+	Payouts(prefill ...*Payout) PayoutService
+	Plans(prefill ...*Plan) PlanService
+	Products(prefill ...*Product) ProductService
+	Projects(prefill ...*Project) ProjectService
+	Refunds(prefill ...*Refund) RefundService
+	Subscriptions(prefill ...*Subscription) SubscriptionService
+	Transactions(prefill ...*Transaction) TransactionService
+	Webhooks(prefill ...*Webhook) WebhookService
+	WebhookEndpoints(prefill ...*WebhookEndpoint) WebhookEndpointService
+}
+
+var _ Client = (*ProcessOut)(nil)
+
+// ActivityService describes the operations available on an Activity. It is
+// implemented by Activity, and can be mocked using the processoutmock
+// package
+type ActivityService interface {
+	// All allows you to get all the project activities.
+	All(options ...ActivityAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the project activities.
+	AllWithContext(ctx context.Context, options ...ActivityAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the project activities, through an iterator of *Activity.
+	AllTyped(options ...ActivityAllParameters) (*TypedIterator[*Activity], error)
+
+	// AllTypedWithContext allows you to get all the project activities, through an iterator of *Activity.
+	AllTypedWithContext(ctx context.Context, options ...ActivityAllParameters) (*TypedIterator[*Activity], error)
+
+	// Find allows you to find a specific activity and fetch its data.
+	Find(activityID string, options ...ActivityFindParameters) (*Activity, error)
+
+	// FindWithContext allows you to find a specific activity and fetch its data.
+	FindWithContext(ctx context.Context, activityID string, options ...ActivityFindParameters) (*Activity, error)
+}
+
+var _ ActivityService = Activity{}
+
+// Activities returns the service of the Activity resource, prefilled with
+// the given Activity. It is equivalent to NewActivity, but returns an
+// interface that can be mocked
+func (c *ProcessOut) Activities(prefill ...*Activity) ActivityService {
+	return c.NewActivity(prefill...)
+}
+
+// AddonService describes the operations available on an Addon. It is
+// implemented by Addon, and can be mocked using the processoutmock package
+type AddonService interface {
+	// FetchSubscriptionAddons allows you to get the addons applied to the subscription.
+	FetchSubscriptionAddons(subscriptionID string, options ...AddonFetchSubscriptionAddonsParameters) (*Iterator, error)
+
+	// FetchSubscriptionAddonsWithContext allows you to get the addons applied to the subscription.
+	FetchSubscriptionAddonsWithContext(ctx context.Context, subscriptionID string, options ...AddonFetchSubscriptionAddonsParameters) (*Iterator, error)
+
+	// FetchSubscriptionAddonsTyped allows you to get the addons applied to the subscription, through an iterator of *Addon.
+	FetchSubscriptionAddonsTyped(subscriptionID string, options ...AddonFetchSubscriptionAddonsParameters) (*TypedIterator[*Addon], error)
+
+	// FetchSubscriptionAddonsTypedWithContext allows you to get the addons applied to the subscription, through an iterator of *Addon.
+	FetchSubscriptionAddonsTypedWithContext(ctx context.Context, subscriptionID string, options ...AddonFetchSubscriptionAddonsParameters) (*TypedIterator[*Addon], error)
+
+	// Create allows you to create a new addon to the given subscription ID.
+	Create(options ...AddonCreateParameters) (*Addon, error)
+
+	// CreateWithContext allows you to create a new addon to the given subscription ID.
+	CreateWithContext(ctx context.Context, options ...AddonCreateParameters) (*Addon, error)
+
+	// Find allows you to find a subscription's addon by its ID.
+	Find(subscriptionID, addonID string, options ...AddonFindParameters) (*Addon, error)
+
+	// FindWithContext allows you to find a subscription's addon by its ID.
+	FindWithContext(ctx context.Context, subscriptionID, addonID string, options ...AddonFindParameters) (*Addon, error)
+
+	// Save allows you to save the updated addon attributes.
+	Save(options ...AddonSaveParameters) (*Addon, error)
+
+	// SaveWithContext allows you to save the updated addon attributes.
+	SaveWithContext(ctx context.Context, options ...AddonSaveParameters) (*Addon, error)
+
+	// Delete allows you to delete an addon applied to a subscription.
+	Delete(options ...AddonDeleteParameters) error
+
+	// DeleteWithContext allows you to delete an addon applied to a subscription.
+	DeleteWithContext(ctx context.Context, options ...AddonDeleteParameters) error
+}
+
+var _ AddonService = Addon{}
+
+// Addons returns the service of the Addon resource, prefilled with the given
+// Addon. It is equivalent to NewAddon, but returns an interface that can be
+// mocked
+func (c *ProcessOut) Addons(prefill ...*Addon) AddonService {
+	return c.NewAddon(prefill...)
+}
+
+// APIRequestService describes the operations available on an APIRequest. It
+// is implemented by APIRequest, and can be mocked using the processoutmock
+// package
+type APIRequestService interface {
+	// All allows you to get all the API requests.
+	All(options ...APIRequestAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the API requests.
+	AllWithContext(ctx context.Context, options ...APIRequestAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the API requests, through an iterator of *APIRequest.
+	AllTyped(options ...APIRequestAllParameters) (*TypedIterator[*APIRequest], error)
+
+	// AllTypedWithContext allows you to get all the API requests, through an iterator of *APIRequest.
+	AllTypedWithContext(ctx context.Context, options ...APIRequestAllParameters) (*TypedIterator[*APIRequest], error)
+
+	// Find allows you to find an API request by its ID.
+	Find(APIRequestID string, options ...APIRequestFindParameters) (*APIRequest, error)
+
+	// FindWithContext allows you to find an API request by its ID.
+	FindWithContext(ctx context.Context, APIRequestID string, options ...APIRequestFindParameters) (*APIRequest, error)
+}
+
+var _ APIRequestService = APIRequest{}
+
+// APIRequests returns the service of the APIRequest resource, prefilled with
+// the given APIRequest. It is equivalent to NewAPIRequest, but returns an
+// interface that can be mocked
+func (c *ProcessOut) APIRequests(prefill ...*APIRequest) APIRequestService {
+	return c.NewAPIRequest(prefill...)
+}
+
+// CardService describes the operations available on a Card. It is
+// implemented by Card, and can be mocked using the processoutmock package
+type CardService interface {
+	// All allows you to get all the cards.
+	All(options ...CardAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the cards.
+	AllWithContext(ctx context.Context, options ...CardAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the cards, through an iterator of *Card.
+	AllTyped(options ...CardAllParameters) (*TypedIterator[*Card], error)
+
+	// AllTypedWithContext allows you to get all the cards, through an iterator of *Card.
+	AllTypedWithContext(ctx context.Context, options ...CardAllParameters) (*TypedIterator[*Card], error)
+
+	// Find allows you to find a card by its ID.
+	Find(cardID string, options ...CardFindParameters) (*Card, error)
+
+	// FindWithContext allows you to find a card by its ID.
+	FindWithContext(ctx context.Context, cardID string, options ...CardFindParameters) (*Card, error)
+
+	// Anonymize allows you to anonymize the card.
+	Anonymize(options ...CardAnonymizeParameters) error
+
+	// AnonymizeWithContext allows you to anonymize the card.
+	AnonymizeWithContext(ctx context.Context, options ...CardAnonymizeParameters) error
+}
+
+var _ CardService = Card{}
+
+// Cards returns the service of the Card resource, prefilled with the given
+// Card. It is equivalent to NewCard, but returns an interface that can be
+// mocked
+func (c *ProcessOut) Cards(prefill ...*Card) CardService {
+	return c.NewCard(prefill...)
+}
+
+// CardInformationService describes the operations available on a
+// CardInformation. It is implemented by CardInformation, and can be mocked
+// using the processoutmock package
+type CardInformationService interface {
+	// Fetch allows you to fetch card information from the IIN.
+	Fetch(iin string, options ...CardInformationFetchParameters) (*CardInformation, error)
+
+	// FetchWithContext allows you to fetch card information from the IIN.
+	FetchWithContext(ctx context.Context, iin string, options ...CardInformationFetchParameters) (*CardInformation, error)
+}
+
+var _ CardInformationService = CardInformation{}
+
+// CardInformation returns the service of the CardInformation resource,
+// prefilled with the given CardInformation. It is equivalent to
+// NewCardInformation, but returns an interface that can be mocked
+func (c *ProcessOut) CardInformation(prefill ...*CardInformation) CardInformationService {
+	return c.NewCardInformation(prefill...)
+}
+
+// CouponService describes the operations available on a Coupon. It is
+// implemented by Coupon, and can be mocked using the processoutmock package
+type CouponService interface {
+	// All allows you to get all the coupons.
+	All(options ...CouponAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the coupons.
+	AllWithContext(ctx context.Context, options ...CouponAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the coupons, through an iterator of *Coupon.
+	AllTyped(options ...CouponAllParameters) (*TypedIterator[*Coupon], error)
+
+	// AllTypedWithContext allows you to get all the coupons, through an iterator of *Coupon.
+	AllTypedWithContext(ctx context.Context, options ...CouponAllParameters) (*TypedIterator[*Coupon], error)
+
+	// Create allows you to create a new coupon.
+	Create(options ...CouponCreateParameters) (*Coupon, error)
+
+	// CreateWithContext allows you to create a new coupon.
+	CreateWithContext(ctx context.Context, options ...CouponCreateParameters) (*Coupon, error)
+
+	// Find allows you to find a coupon by its ID.
+	Find(couponID string, options ...CouponFindParameters) (*Coupon, error)
+
+	// FindWithContext allows you to find a coupon by its ID.
+	FindWithContext(ctx context.Context, couponID string, options ...CouponFindParameters) (*Coupon, error)
+
+	// Save allows you to save the updated coupon attributes.
+	Save(options ...CouponSaveParameters) (*Coupon, error)
+
+	// SaveWithContext allows you to save the updated coupon attributes.
+	SaveWithContext(ctx context.Context, options ...CouponSaveParameters) (*Coupon, error)
+
+	// Delete allows you to delete the coupon.
+	Delete(options ...CouponDeleteParameters) error
+
+	// DeleteWithContext allows you to delete the coupon.
+	DeleteWithContext(ctx context.Context, options ...CouponDeleteParameters) error
+}
+
+var _ CouponService = Coupon{}
+
+// Coupons returns the service of the Coupon resource, prefilled with the
+// given Coupon. It is equivalent to NewCoupon, but returns an interface that
+// can be mocked
+func (c *ProcessOut) Coupons(prefill ...*Coupon) CouponService {
+	return c.NewCoupon(prefill...)
+}
+
+// CustomerService describes the operations available on a Customer. It is
+// implemented by Customer, and can be mocked using the processoutmock
+// package
+type CustomerService interface {
+	// FetchSubscriptions allows you to get the subscriptions belonging to the customer.
+	FetchSubscriptions(options ...CustomerFetchSubscriptionsParameters) (*Iterator, error)
+
+	// FetchSubscriptionsWithContext allows you to get the subscriptions belonging to the customer.
+	FetchSubscriptionsWithContext(ctx context.Context, options ...CustomerFetchSubscriptionsParameters) (*Iterator, error)
+
+	// FetchSubscriptionsTyped allows you to get the subscriptions belonging to the customer, through an iterator of *Subscription.
+	FetchSubscriptionsTyped(options ...CustomerFetchSubscriptionsParameters) (*TypedIterator[*Subscription], error)
+
+	// FetchSubscriptionsTypedWithContext allows you to get the subscriptions belonging to the customer, through an iterator of *Subscription.
+	FetchSubscriptionsTypedWithContext(ctx context.Context, options ...CustomerFetchSubscriptionsParameters) (*TypedIterator[*Subscription], error)
+
+	// FetchTokens allows you to get the customer's tokens.
+	FetchTokens(options ...CustomerFetchTokensParameters) (*Iterator, error)
+
+	// FetchTokensWithContext allows you to get the customer's tokens.
+	FetchTokensWithContext(ctx context.Context, options ...CustomerFetchTokensParameters) (*Iterator, error)
+
+	// FetchTokensTyped allows you to get the customer's tokens, through an iterator of *Token.
+	FetchTokensTyped(options ...CustomerFetchTokensParameters) (*TypedIterator[*Token], error)
+
+	// FetchTokensTypedWithContext allows you to get the customer's tokens, through an iterator of *Token.
+	FetchTokensTypedWithContext(ctx context.Context, options ...CustomerFetchTokensParameters) (*TypedIterator[*Token], error)
+
+	// FindToken allows you to find a customer's token by its ID.
+	FindToken(tokenID string, options ...CustomerFindTokenParameters) (*Token, error)
+
+	// FindTokenWithContext allows you to find a customer's token by its ID.
+	FindTokenWithContext(ctx context.Context, tokenID string, options ...CustomerFindTokenParameters) (*Token, error)
+
+	// DeleteToken allows you to delete a customer's token by its ID.
+	DeleteToken(tokenID string, options ...CustomerDeleteTokenParameters) error
+
+	// DeleteTokenWithContext allows you to delete a customer's token by its ID.
+	DeleteTokenWithContext(ctx context.Context, tokenID string, options ...CustomerDeleteTokenParameters) error
+
+	// FetchTransactions allows you to get the transactions belonging to the customer.
+	FetchTransactions(options ...CustomerFetchTransactionsParameters) (*Iterator, error)
+
+	// FetchTransactionsWithContext allows you to get the transactions belonging to the customer.
+	FetchTransactionsWithContext(ctx context.Context, options ...CustomerFetchTransactionsParameters) (*Iterator, error)
+
+	// FetchTransactionsTyped allows you to get the transactions belonging to the customer, through an iterator of *Transaction.
+	FetchTransactionsTyped(options ...CustomerFetchTransactionsParameters) (*TypedIterator[*Transaction], error)
+
+	// FetchTransactionsTypedWithContext allows you to get the transactions belonging to the customer, through an iterator of *Transaction.
+	FetchTransactionsTypedWithContext(ctx context.Context, options ...CustomerFetchTransactionsParameters) (*TypedIterator[*Transaction], error)
+
+	// All allows you to get all the customers.
+	All(options ...CustomerAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the customers.
+	AllWithContext(ctx context.Context, options ...CustomerAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the customers, through an iterator of *Customer.
+	AllTyped(options ...CustomerAllParameters) (*TypedIterator[*Customer], error)
+
+	// AllTypedWithContext allows you to get all the customers, through an iterator of *Customer.
+	AllTypedWithContext(ctx context.Context, options ...CustomerAllParameters) (*TypedIterator[*Customer], error)
+
+	// Create allows you to create a new customer.
+	Create(options ...CustomerCreateParameters) (*Customer, error)
+
+	// CreateWithContext allows you to create a new customer.
+	CreateWithContext(ctx context.Context, options ...CustomerCreateParameters) (*Customer, error)
+
+	// Find allows you to find a customer by its ID.
+	Find(customerID string, options ...CustomerFindParameters) (*Customer, error)
+
+	// FindWithContext allows you to find a customer by its ID.
+	FindWithContext(ctx context.Context, customerID string, options ...CustomerFindParameters) (*Customer, error)
+
+	// Save allows you to save the updated customer attributes.
+	Save(options ...CustomerSaveParameters) (*Customer, error)
+
+	// SaveWithContext allows you to save the updated customer attributes.
+	SaveWithContext(ctx context.Context, options ...CustomerSaveParameters) (*Customer, error)
+
+	// Delete allows you to delete the customer.
+	Delete(options ...CustomerDeleteParameters) error
+
+	// DeleteWithContext allows you to delete the customer.
+	DeleteWithContext(ctx context.Context, options ...CustomerDeleteParameters) error
+}
+
+var _ CustomerService = Customer{}
+
+// Customers returns the service of the Customer resource, prefilled with the
+// given Customer. It is equivalent to NewCustomer, but returns an interface
+// that can be mocked
+func (c *ProcessOut) Customers(prefill ...*Customer) CustomerService {
+	return c.NewCustomer(prefill...)
+}
+
+// TokenService describes the operations available on a Token. It is
+// implemented by Token, and can be mocked using the processoutmock package
+type TokenService interface {
+	// FetchCustomerTokens allows you to get the customer's tokens.
+	FetchCustomerTokens(customerID string, options ...TokenFetchCustomerTokensParameters) (*Iterator, error)
+
+	// FetchCustomerTokensWithContext allows you to get the customer's tokens.
+	FetchCustomerTokensWithContext(ctx context.Context, customerID string, options ...TokenFetchCustomerTokensParameters) (*Iterator, error)
+
+	// FetchCustomerTokensTyped allows you to get the customer's tokens, through an iterator of *Token.
+	FetchCustomerTokensTyped(customerID string, options ...TokenFetchCustomerTokensParameters) (*TypedIterator[*Token], error)
+
+	// FetchCustomerTokensTypedWithContext allows you to get the customer's tokens, through an iterator of *Token.
+	FetchCustomerTokensTypedWithContext(ctx context.Context, customerID string, options ...TokenFetchCustomerTokensParameters) (*TypedIterator[*Token], error)
+
+	// Find allows you to find a customer's token by its ID.
+	Find(customerID, tokenID string, options ...TokenFindParameters) (*Token, error)
+
+	// FindWithContext allows you to find a customer's token by its ID.
+	FindWithContext(ctx context.Context, customerID, tokenID string, options ...TokenFindParameters) (*Token, error)
+
+	// Create allows you to create a new token for the given customer ID.
+	Create(options ...TokenCreateParameters) (*Token, error)
+
+	// CreateWithContext allows you to create a new token for the given customer ID.
+	CreateWithContext(ctx context.Context, options ...TokenCreateParameters) (*Token, error)
+
+	// Save allows you to save the updated customer attributes.
+	Save(options ...TokenSaveParameters) error
+
+	// SaveWithContext allows you to save the updated customer attributes.
+	SaveWithContext(ctx context.Context, options ...TokenSaveParameters) error
+
+	// Delete allows you to delete a customer token
+	Delete(options ...TokenDeleteParameters) error
+
+	// DeleteWithContext allows you to delete a customer token
+	DeleteWithContext(ctx context.Context, options ...TokenDeleteParameters) error
+}
+
+var _ TokenService = Token{}
+
+// Tokens returns the service of the Token resource, prefilled with the given
+// Token. It is equivalent to NewToken, but returns an interface that can be
+// mocked
+func (c *ProcessOut) Tokens(prefill ...*Token) TokenService {
+	return c.NewToken(prefill...)
+}
+
+// DiscountService describes the operations available on a Discount. It is
+// implemented by Discount, and can be mocked using the processoutmock
+// package
+type DiscountService interface {
+	// FetchSubscriptionDiscounts allows you to get the discounts applied to the subscription.
+	FetchSubscriptionDiscounts(subscriptionID string, options ...DiscountFetchSubscriptionDiscountsParameters) (*Iterator, error)
+
+	// FetchSubscriptionDiscountsWithContext allows you to get the discounts applied to the subscription.
+	FetchSubscriptionDiscountsWithContext(ctx context.Context, subscriptionID string, options ...DiscountFetchSubscriptionDiscountsParameters) (*Iterator, error)
+
+	// FetchSubscriptionDiscountsTyped allows you to get the discounts applied to the subscription, through an iterator of *Discount.
+	FetchSubscriptionDiscountsTyped(subscriptionID string, options ...DiscountFetchSubscriptionDiscountsParameters) (*TypedIterator[*Discount], error)
+
+	// FetchSubscriptionDiscountsTypedWithContext allows you to get the discounts applied to the subscription, through an iterator of *Discount.
+	FetchSubscriptionDiscountsTypedWithContext(ctx context.Context, subscriptionID string, options ...DiscountFetchSubscriptionDiscountsParameters) (*TypedIterator[*Discount], error)
+
+	// Create allows you to create a new discount for the given subscription ID.
+	Create(options ...DiscountCreateParameters) (*Discount, error)
+
+	// CreateWithContext allows you to create a new discount for the given subscription ID.
+	CreateWithContext(ctx context.Context, options ...DiscountCreateParameters) (*Discount, error)
+
+	// Find allows you to find a subscription's discount by its ID.
+	Find(subscriptionID, discountID string, options ...DiscountFindParameters) (*Discount, error)
+
+	// FindWithContext allows you to find a subscription's discount by its ID.
+	FindWithContext(ctx context.Context, subscriptionID, discountID string, options ...DiscountFindParameters) (*Discount, error)
+
+	// Delete allows you to delete a discount applied to a subscription.
+	Delete(options ...DiscountDeleteParameters) error
+
+	// DeleteWithContext allows you to delete a discount applied to a subscription.
+	DeleteWithContext(ctx context.Context, options ...DiscountDeleteParameters) error
+}
+
+var _ DiscountService = Discount{}
+
+// Discounts returns the service of the Discount resource, prefilled with the
+// given Discount. It is equivalent to NewDiscount, but returns an interface
+// that can be mocked
+func (c *ProcessOut) Discounts(prefill ...*Discount) DiscountService {
+	return c.NewDiscount(prefill...)
+}
+
+// EventService describes the operations available on an Event. It is
+// implemented by Event, and can be mocked using the processoutmock package
+type EventService interface {
+	// FetchWebhooks allows you to get all the webhooks of the event.
+	FetchWebhooks(options ...EventFetchWebhooksParameters) (*Iterator, error)
+
+	// FetchWebhooksWithContext allows you to get all the webhooks of the event.
+	FetchWebhooksWithContext(ctx context.Context, options ...EventFetchWebhooksParameters) (*Iterator, error)
+
+	// FetchWebhooksTyped allows you to get all the webhooks of the event, through an iterator of *Webhook.
+	FetchWebhooksTyped(options ...EventFetchWebhooksParameters) (*TypedIterator[*Webhook], error)
+
+	// FetchWebhooksTypedWithContext allows you to get all the webhooks of the event, through an iterator of *Webhook.
+	FetchWebhooksTypedWithContext(ctx context.Context, options ...EventFetchWebhooksParameters) (*TypedIterator[*Webhook], error)
+
+	// All allows you to get all the events.
+	All(options ...EventAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the events.
+	AllWithContext(ctx context.Context, options ...EventAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the events, through an iterator of *Event.
+	AllTyped(options ...EventAllParameters) (*TypedIterator[*Event], error)
+
+	// AllTypedWithContext allows you to get all the events, through an iterator of *Event.
+	AllTypedWithContext(ctx context.Context, options ...EventAllParameters) (*TypedIterator[*Event], error)
+
+	// Find allows you to find an event by its ID.
+	Find(eventID string, options ...EventFindParameters) (*Event, error)
+
+	// FindWithContext allows you to find an event by its ID.
+	FindWithContext(ctx context.Context, eventID string, options ...EventFindParameters) (*Event, error)
+}
+
+var _ EventService = Event{}
+
+// Events returns the service of the Event resource, prefilled with the given
+// Event. It is equivalent to NewEvent, but returns an interface that can be
+// mocked
+func (c *ProcessOut) Events(prefill ...*Event) EventService {
+	return c.NewEvent(prefill...)
+}
+
+// GatewayService describes the operations available on a Gateway. It is
+// implemented by Gateway, and can be mocked using the processoutmock package
+type GatewayService interface {
+	// FetchGatewayConfigurations allows you to get all the gateway configurations of the gateway
+	FetchGatewayConfigurations(options ...GatewayFetchGatewayConfigurationsParameters) (*Iterator, error)
+
+	// FetchGatewayConfigurationsWithContext allows you to get all the gateway configurations of the gateway
+	FetchGatewayConfigurationsWithContext(ctx context.Context, options ...GatewayFetchGatewayConfigurationsParameters) (*Iterator, error)
+
+	// FetchGatewayConfigurationsTyped allows you to get all the gateway configurations of the gateway, through an iterator of *GatewayConfiguration.
+	FetchGatewayConfigurationsTyped(options ...GatewayFetchGatewayConfigurationsParameters) (*TypedIterator[*GatewayConfiguration], error)
+
+	// FetchGatewayConfigurationsTypedWithContext allows you to get all the gateway configurations of the gateway, through an iterator of *GatewayConfiguration.
+	FetchGatewayConfigurationsTypedWithContext(ctx context.Context, options ...GatewayFetchGatewayConfigurationsParameters) (*TypedIterator[*GatewayConfiguration], error)
+}
+
+var _ GatewayService = Gateway{}
+
+// Gateways returns the service of the Gateway resource, prefilled with the
+// given Gateway. It is equivalent to NewGateway, but returns an interface
+// that can be mocked
+func (c *ProcessOut) Gateways(prefill ...*Gateway) GatewayService {
+	return c.NewGateway(prefill...)
+}
+
+// GatewayConfigurationService describes the operations available on a
+// GatewayConfiguration. It is implemented by GatewayConfiguration, and can
+// be mocked using the processoutmock package
+type GatewayConfigurationService interface {
+	// All allows you to get all the gateway configurations.
+	All(options ...GatewayConfigurationAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the gateway configurations.
+	AllWithContext(ctx context.Context, options ...GatewayConfigurationAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the gateway configurations, through an iterator of *GatewayConfiguration.
+	AllTyped(options ...GatewayConfigurationAllParameters) (*TypedIterator[*GatewayConfiguration], error)
+
+	// AllTypedWithContext allows you to get all the gateway configurations, through an iterator of *GatewayConfiguration.
+	AllTypedWithContext(ctx context.Context, options ...GatewayConfigurationAllParameters) (*TypedIterator[*GatewayConfiguration], error)
+
+	// Find allows you to find a gateway configuration by its ID.
+	Find(configurationID string, options ...GatewayConfigurationFindParameters) (*GatewayConfiguration, error)
+
+	// FindWithContext allows you to find a gateway configuration by its ID.
+	FindWithContext(ctx context.Context, configurationID string, options ...GatewayConfigurationFindParameters) (*GatewayConfiguration, error)
+
+	// Save allows you to save the updated gateway configuration attributes and settings.
+	Save(options ...GatewayConfigurationSaveParameters) (*GatewayConfiguration, error)
+
+	// SaveWithContext allows you to save the updated gateway configuration attributes and settings.
+	SaveWithContext(ctx context.Context, options ...GatewayConfigurationSaveParameters) (*GatewayConfiguration, error)
+
+	// Delete allows you to delete the gateway configuration.
+	Delete(options ...GatewayConfigurationDeleteParameters) error
+
+	// DeleteWithContext allows you to delete the gateway configuration.
+	DeleteWithContext(ctx context.Context, options ...GatewayConfigurationDeleteParameters) error
+
+	// Create allows you to create a new gateway configuration.
+	Create(gatewayName string, options ...GatewayConfigurationCreateParameters) (*GatewayConfiguration, error)
+
+	// CreateWithContext allows you to create a new gateway configuration.
+	CreateWithContext(ctx context.Context, gatewayName string, options ...GatewayConfigurationCreateParameters) (*GatewayConfiguration, error)
+}
+
+var _ GatewayConfigurationService = GatewayConfiguration{}
+
+// GatewayConfigurations returns the service of the GatewayConfiguration
+// resource, prefilled with the given GatewayConfiguration. It is equivalent
+// to NewGatewayConfiguration, but returns an interface that can be mocked
+func (c *ProcessOut) GatewayConfigurations(prefill ...*GatewayConfiguration) GatewayConfigurationService {
+	return c.NewGatewayConfiguration(prefill...)
+}
+
+// InvoiceService describes the operations available on an Invoice. It is
+// implemented by Invoice, and can be mocked using the processoutmock package
+type InvoiceService interface {
+	// Authorize allows you to authorize the invoice using the given source (customer or token)
+	Authorize(source string, options ...InvoiceAuthorizeParameters) (*Transaction, error)
+
+	// AuthorizeWithContext allows you to authorize the invoice using the given source (customer or token)
+	AuthorizeWithContext(ctx context.Context, source string, options ...InvoiceAuthorizeParameters) (*Transaction, error)
+
+	// Capture allows you to capture the invoice using the given source (customer or token)
+	Capture(source string, options ...InvoiceCaptureParameters) (*Transaction, error)
+
+	// CaptureWithContext allows you to capture the invoice using the given source (customer or token)
+	CaptureWithContext(ctx context.Context, source string, options ...InvoiceCaptureParameters) (*Transaction, error)
+
+	// FetchCustomer allows you to get the customer linked to the invoice.
+	FetchCustomer(options ...InvoiceFetchCustomerParameters) (*Customer, error)
+
+	// FetchCustomerWithContext allows you to get the customer linked to the invoice.
+	FetchCustomerWithContext(ctx context.Context, options ...InvoiceFetchCustomerParameters) (*Customer, error)
+
+	// AssignCustomer allows you to assign a customer to the invoice.
+	AssignCustomer(customerID string, options ...InvoiceAssignCustomerParameters) (*Customer, error)
+
+	// AssignCustomerWithContext allows you to assign a customer to the invoice.
+	AssignCustomerWithContext(ctx context.Context, customerID string, options ...InvoiceAssignCustomerParameters) (*Customer, error)
+
+	// InitiateThreeDS allows you to initiate a 3-D Secure authentication
+	InitiateThreeDS(source string, options ...InvoiceInitiateThreeDSParameters) (*CustomerAction, error)
+
+	// InitiateThreeDSWithContext allows you to initiate a 3-D Secure authentication
+	InitiateThreeDSWithContext(ctx context.Context, source string, options ...InvoiceInitiateThreeDSParameters) (*CustomerAction, error)
+
+	// FetchTransaction allows you to get the transaction of the invoice.
+	FetchTransaction(options ...InvoiceFetchTransactionParameters) (*Transaction, error)
+
+	// FetchTransactionWithContext allows you to get the transaction of the invoice.
+	FetchTransactionWithContext(ctx context.Context, options ...InvoiceFetchTransactionParameters) (*Transaction, error)
+
+	// Void allows you to void the invoice
+	Void(options ...InvoiceVoidParameters) (*Transaction, error)
+
+	// VoidWithContext allows you to void the invoice
+	VoidWithContext(ctx context.Context, options ...InvoiceVoidParameters) (*Transaction, error)
+
+	// All allows you to get all the invoices.
+	All(options ...InvoiceAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the invoices.
+	AllWithContext(ctx context.Context, options ...InvoiceAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the invoices, through an iterator of *Invoice.
+	AllTyped(options ...InvoiceAllParameters) (*TypedIterator[*Invoice], error)
+
+	// AllTypedWithContext allows you to get all the invoices, through an iterator of *Invoice.
+	AllTypedWithContext(ctx context.Context, options ...InvoiceAllParameters) (*TypedIterator[*Invoice], error)
+
+	// Create allows you to create a new invoice.
+	Create(options ...InvoiceCreateParameters) (*Invoice, error)
+
+	// CreateWithContext allows you to create a new invoice.
+	CreateWithContext(ctx context.Context, options ...InvoiceCreateParameters) (*Invoice, error)
+
+	// Find allows you to find an invoice by its ID.
+	Find(invoiceID string, options ...InvoiceFindParameters) (*Invoice, error)
+
+	// FindWithContext allows you to find an invoice by its ID.
+	FindWithContext(ctx context.Context, invoiceID string, options ...InvoiceFindParameters) (*Invoice, error)
+}
+
+var _ InvoiceService = Invoice{}
+
+// Invoices returns the service of the Invoice resource, prefilled with the
+// given Invoice. It is equivalent to NewInvoice, but returns an interface
+// that can be mocked
+func (c *ProcessOut) Invoices(prefill ...*Invoice) InvoiceService {
+	return c.NewInvoice(prefill...)
+}
+
+// PayoutService describes the operations available on a Payout. It is
+// implemented by Payout, and can be mocked using the processoutmock package
+type PayoutService interface {
+	// FetchItems allows you to get all the items linked to the payout.
+	FetchItems(options ...PayoutFetchItemsParameters) (*Iterator, error)
+
+	// FetchItemsWithContext allows you to get all the items linked to the payout.
+	FetchItemsWithContext(ctx context.Context, options ...PayoutFetchItemsParameters) (*Iterator, error)
+
+	// FetchItemsTyped allows you to get all the items linked to the payout, through an iterator of *PayoutItem.
+	FetchItemsTyped(options ...PayoutFetchItemsParameters) (*TypedIterator[*PayoutItem], error)
+
+	// FetchItemsTypedWithContext allows you to get all the items linked to the payout, through an iterator of *PayoutItem.
+	FetchItemsTypedWithContext(ctx context.Context, options ...PayoutFetchItemsParameters) (*TypedIterator[*PayoutItem], error)
+
+	// All allows you to get all the payouts.
+	All(options ...PayoutAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the payouts.
+	AllWithContext(ctx context.Context, options ...PayoutAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the payouts, through an iterator of *Payout.
+	AllTyped(options ...PayoutAllParameters) (*TypedIterator[*Payout], error)
+
+	// AllTypedWithContext allows you to get all the payouts, through an iterator of *Payout.
+	AllTypedWithContext(ctx context.Context, options ...PayoutAllParameters) (*TypedIterator[*Payout], error)
+
+	// Find allows you to find a payout by its ID.
+	Find(payoutID string, options ...PayoutFindParameters) (*Payout, error)
+
+	// FindWithContext allows you to find a payout by its ID.
+	FindWithContext(ctx context.Context, payoutID string, options ...PayoutFindParameters) (*Payout, error)
+}
+
+var _ PayoutService = Payout{}
+
+// Payouts returns the service of the Payout resource, prefilled with the
+// given Payout. It is equivalent to NewPayout, but returns an interface that
+// can be mocked
+func (c *ProcessOut) Payouts(prefill ...*Payout) PayoutService {
+	return c.NewPayout(prefill...)
+}
+
+// PlanService describes the operations available on a Plan. It is
+// implemented by Plan, and can be mocked using the processoutmock package
+type PlanService interface {
+	// All allows you to get all the plans.
+	All(options ...PlanAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the plans.
+	AllWithContext(ctx context.Context, options ...PlanAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the plans, through an iterator of *Plan.
+	AllTyped(options ...PlanAllParameters) (*TypedIterator[*Plan], error)
+
+	// AllTypedWithContext allows you to get all the plans, through an iterator of *Plan.
+	AllTypedWithContext(ctx context.Context, options ...PlanAllParameters) (*TypedIterator[*Plan], error)
+
+	// Create allows you to create a new plan.
+	Create(options ...PlanCreateParameters) (*Plan, error)
+
+	// CreateWithContext allows you to create a new plan.
+	CreateWithContext(ctx context.Context, options ...PlanCreateParameters) (*Plan, error)
+
+	// Find allows you to find a plan by its ID.
+	Find(planID string, options ...PlanFindParameters) (*Plan, error)
+
+	// FindWithContext allows you to find a plan by its ID.
+	FindWithContext(ctx context.Context, planID string, options ...PlanFindParameters) (*Plan, error)
+
+	// Save allows you to save the updated plan attributes. This action won't affect subscriptions already linked to this plan.
+	Save(options ...PlanSaveParameters) (*Plan, error)
+
+	// SaveWithContext allows you to save the updated plan attributes. This action won't affect subscriptions already linked to this plan.
+	SaveWithContext(ctx context.Context, options ...PlanSaveParameters) (*Plan, error)
+
+	// End allows you to delete a plan. Subscriptions linked to this plan won't be affected.
+	End(options ...PlanEndParameters) error
+
+	// EndWithContext allows you to delete a plan. Subscriptions linked to this plan won't be affected.
+	EndWithContext(ctx context.Context, options ...PlanEndParameters) error
+}
+
+var _ PlanService = Plan{}
+
+// Plans returns the service of the Plan resource, prefilled with the given
+// Plan. It is equivalent to NewPlan, but returns an interface that can be
+// mocked
+func (c *ProcessOut) Plans(prefill ...*Plan) PlanService {
+	return c.NewPlan(prefill...)
+}
+
+// ProductService describes the operations available on a Product. It is
+// implemented by Product, and can be mocked using the processoutmock package
+type ProductService interface {
+	// CreateInvoice allows you to create a new invoice from the product.
+	CreateInvoice(options ...ProductCreateInvoiceParameters) (*Invoice, error)
+
+	// CreateInvoiceWithContext allows you to create a new invoice from the product.
+	CreateInvoiceWithContext(ctx context.Context, options ...ProductCreateInvoiceParameters) (*Invoice, error)
+
+	// All allows you to get all the products.
+	All(options ...ProductAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the products.
+	AllWithContext(ctx context.Context, options ...ProductAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the products, through an iterator of *Product.
+	AllTyped(options ...ProductAllParameters) (*TypedIterator[*Product], error)
+
+	// AllTypedWithContext allows you to get all the products, through an iterator of *Product.
+	AllTypedWithContext(ctx context.Context, options ...ProductAllParameters) (*TypedIterator[*Product], error)
+
+	// Create allows you to create a new product.
+	Create(options ...ProductCreateParameters) (*Product, error)
+
+	// CreateWithContext allows you to create a new product.
+	CreateWithContext(ctx context.Context, options ...ProductCreateParameters) (*Product, error)
+
+	// Find allows you to find a product by its ID.
+	Find(productID string, options ...ProductFindParameters) (*Product, error)
+
+	// FindWithContext allows you to find a product by its ID.
+	FindWithContext(ctx context.Context, productID string, options ...ProductFindParameters) (*Product, error)
+
+	// Save allows you to save the updated product attributes.
+	Save(options ...ProductSaveParameters) (*Product, error)
+
+	// SaveWithContext allows you to save the updated product attributes.
+	SaveWithContext(ctx context.Context, options ...ProductSaveParameters) (*Product, error)
+
+	// Delete allows you to delete the product.
+	Delete(options ...ProductDeleteParameters) error
+
+	// DeleteWithContext allows you to delete the product.
+	DeleteWithContext(ctx context.Context, options ...ProductDeleteParameters) error
+}
+
+var _ ProductService = Product{}
+
+// Products returns the service of the Product resource, prefilled with the
+// given Product. It is equivalent to NewProduct, but returns an interface
+// that can be mocked
+func (c *ProcessOut) Products(prefill ...*Product) ProductService {
+	return c.NewProduct(prefill...)
+}
+
+// ProjectService describes the operations available on a Project. It is
+// implemented by Project, and can be mocked using the processoutmock package
+type ProjectService interface {
+	// Fetch allows you to fetch the current project information.
+	Fetch(options ...ProjectFetchParameters) (*Project, error)
+
+	// FetchWithContext allows you to fetch the current project information.
+	FetchWithContext(ctx context.Context, options ...ProjectFetchParameters) (*Project, error)
+
+	// Save allows you to save the updated project's attributes.
+	Save(options ...ProjectSaveParameters) (*Project, error)
+
+	// SaveWithContext allows you to save the updated project's attributes.
+	SaveWithContext(ctx context.Context, options ...ProjectSaveParameters) (*Project, error)
+
+	// Delete allows you to delete the project. Be careful! Executing this request will prevent any further interaction with the API that uses this project.
+	Delete(options ...ProjectDeleteParameters) error
+
+	// DeleteWithContext allows you to delete the project. Be careful! Executing this request will prevent any further interaction with the API that uses this project.
+	DeleteWithContext(ctx context.Context, options ...ProjectDeleteParameters) error
+
+	// FetchSupervised allows you to get all the supervised projects.
+	FetchSupervised(options ...ProjectFetchSupervisedParameters) (*Iterator, error)
+
+	// FetchSupervisedWithContext allows you to get all the supervised projects.
+	FetchSupervisedWithContext(ctx context.Context, options ...ProjectFetchSupervisedParameters) (*Iterator, error)
+
+	// FetchSupervisedTyped allows you to get all the supervised projects, through an iterator of *Project.
+	FetchSupervisedTyped(options ...ProjectFetchSupervisedParameters) (*TypedIterator[*Project], error)
+
+	// FetchSupervisedTypedWithContext allows you to get all the supervised projects, through an iterator of *Project.
+	FetchSupervisedTypedWithContext(ctx context.Context, options ...ProjectFetchSupervisedParameters) (*TypedIterator[*Project], error)
+
+	// CreateSupervised allows you to create a new supervised project.
+	CreateSupervised(options ...ProjectCreateSupervisedParameters) (*Project, error)
+
+	// CreateSupervisedWithContext allows you to create a new supervised project.
+	CreateSupervisedWithContext(ctx context.Context, options ...ProjectCreateSupervisedParameters) (*Project, error)
+}
+
+var _ ProjectService = Project{}
+
+// Projects returns the service of the Project resource, prefilled with the
+// given Project. It is equivalent to NewProject, but returns an interface
+// that can be mocked
+func (c *ProcessOut) Projects(prefill ...*Project) ProjectService {
+	return c.NewProject(prefill...)
+}
+
+// RefundService describes the operations available on a Refund. It is
+// implemented by Refund, and can be mocked using the processoutmock package
+type RefundService interface {
+	// FetchTransactionRefunds allows you to get the transaction's refunds.
+	FetchTransactionRefunds(transactionID string, options ...RefundFetchTransactionRefundsParameters) (*Iterator, error)
+
+	// FetchTransactionRefundsWithContext allows you to get the transaction's refunds.
+	FetchTransactionRefundsWithContext(ctx context.Context, transactionID string, options ...RefundFetchTransactionRefundsParameters) (*Iterator, error)
+
+	// FetchTransactionRefundsTyped allows you to get the transaction's refunds, through an iterator of *Refund.
+	FetchTransactionRefundsTyped(transactionID string, options ...RefundFetchTransactionRefundsParameters) (*TypedIterator[*Refund], error)
+
+	// FetchTransactionRefundsTypedWithContext allows you to get the transaction's refunds, through an iterator of *Refund.
+	FetchTransactionRefundsTypedWithContext(ctx context.Context, transactionID string, options ...RefundFetchTransactionRefundsParameters) (*TypedIterator[*Refund], error)
+
+	// Find allows you to find a transaction's refund by its ID.
+	Find(transactionID, refundID string, options ...RefundFindParameters) (*Refund, error)
+
+	// FindWithContext allows you to find a transaction's refund by its ID.
+	FindWithContext(ctx context.Context, transactionID, refundID string, options ...RefundFindParameters) (*Refund, error)
+
+	// Create allows you to create a refund for a transaction.
+	Create(options ...RefundCreateParameters) error
+
+	// CreateWithContext allows you to create a refund for a transaction.
+	CreateWithContext(ctx context.Context, options ...RefundCreateParameters) error
+}
+
+var _ RefundService = Refund{}
+
+// Refunds returns the service of the Refund resource, prefilled with the
+// given Refund. It is equivalent to NewRefund, but returns an interface that
+// can be mocked
+func (c *ProcessOut) Refunds(prefill ...*Refund) RefundService {
+	return c.NewRefund(prefill...)
+}
+
+// SubscriptionService describes the operations available on a Subscription.
+// It is implemented by Subscription, and can be mocked using the
+// processoutmock package
+type SubscriptionService interface {
+	// FetchAddons allows you to get the addons applied to the subscription.
+	FetchAddons(options ...SubscriptionFetchAddonsParameters) (*Iterator, error)
+
+	// FetchAddonsWithContext allows you to get the addons applied to the subscription.
+	FetchAddonsWithContext(ctx context.Context, options ...SubscriptionFetchAddonsParameters) (*Iterator, error)
+
+	// FetchAddonsTyped allows you to get the addons applied to the subscription, through an iterator of *Addon.
+	FetchAddonsTyped(options ...SubscriptionFetchAddonsParameters) (*TypedIterator[*Addon], error)
+
+	// FetchAddonsTypedWithContext allows you to get the addons applied to the subscription, through an iterator of *Addon.
+	FetchAddonsTypedWithContext(ctx context.Context, options ...SubscriptionFetchAddonsParameters) (*TypedIterator[*Addon], error)
+
+	// FindAddon allows you to find a subscription's addon by its ID.
+	FindAddon(addonID string, options ...SubscriptionFindAddonParameters) (*Addon, error)
+
+	// FindAddonWithContext allows you to find a subscription's addon by its ID.
+	FindAddonWithContext(ctx context.Context, addonID string, options ...SubscriptionFindAddonParameters) (*Addon, error)
+
+	// DeleteAddon allows you to delete an addon applied to a subscription.
+	DeleteAddon(addonID string, options ...SubscriptionDeleteAddonParameters) error
+
+	// DeleteAddonWithContext allows you to delete an addon applied to a subscription.
+	DeleteAddonWithContext(ctx context.Context, addonID string, options ...SubscriptionDeleteAddonParameters) error
+
+	// FetchCustomer allows you to get the customer owning the subscription.
+	FetchCustomer(options ...SubscriptionFetchCustomerParameters) (*Customer, error)
+
+	// FetchCustomerWithContext allows you to get the customer owning the subscription.
+	FetchCustomerWithContext(ctx context.Context, options ...SubscriptionFetchCustomerParameters) (*Customer, error)
+
+	// FetchDiscounts allows you to get the discounts applied to the subscription.
+	FetchDiscounts(options ...SubscriptionFetchDiscountsParameters) (*Iterator, error)
+
+	// FetchDiscountsWithContext allows you to get the discounts applied to the subscription.
+	FetchDiscountsWithContext(ctx context.Context, options ...SubscriptionFetchDiscountsParameters) (*Iterator, error)
+
+	// FetchDiscountsTyped allows you to get the discounts applied to the subscription, through an iterator of *Discount.
+	FetchDiscountsTyped(options ...SubscriptionFetchDiscountsParameters) (*TypedIterator[*Discount], error)
+
+	// FetchDiscountsTypedWithContext allows you to get the discounts applied to the subscription, through an iterator of *Discount.
+	FetchDiscountsTypedWithContext(ctx context.Context, options ...SubscriptionFetchDiscountsParameters) (*TypedIterator[*Discount], error)
+
+	// FindDiscount allows you to find a subscription's discount by its ID.
+	FindDiscount(discountID string, options ...SubscriptionFindDiscountParameters) (*Discount, error)
+
+	// FindDiscountWithContext allows you to find a subscription's discount by its ID.
+	FindDiscountWithContext(ctx context.Context, discountID string, options ...SubscriptionFindDiscountParameters) (*Discount, error)
+
+	// DeleteDiscount allows you to delete a discount applied to a subscription.
+	DeleteDiscount(discountID string, options ...SubscriptionDeleteDiscountParameters) error
+
+	// DeleteDiscountWithContext allows you to delete a discount applied to a subscription.
+	DeleteDiscountWithContext(ctx context.Context, discountID string, options ...SubscriptionDeleteDiscountParameters) error
+
+	// FetchTransactions allows you to get the subscriptions past transactions.
+	FetchTransactions(options ...SubscriptionFetchTransactionsParameters) (*Iterator, error)
+
+	// FetchTransactionsWithContext allows you to get the subscriptions past transactions.
+	FetchTransactionsWithContext(ctx context.Context, options ...SubscriptionFetchTransactionsParameters) (*Iterator, error)
+
+	// FetchTransactionsTyped allows you to get the subscriptions past transactions, through an iterator of *Transaction.
+	FetchTransactionsTyped(options ...SubscriptionFetchTransactionsParameters) (*TypedIterator[*Transaction], error)
+
+	// FetchTransactionsTypedWithContext allows you to get the subscriptions past transactions, through an iterator of *Transaction.
+	FetchTransactionsTypedWithContext(ctx context.Context, options ...SubscriptionFetchTransactionsParameters) (*TypedIterator[*Transaction], error)
+
+	// All allows you to get all the subscriptions.
+	All(options ...SubscriptionAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the subscriptions.
+	AllWithContext(ctx context.Context, options ...SubscriptionAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the subscriptions, through an iterator of *Subscription.
+	AllTyped(options ...SubscriptionAllParameters) (*TypedIterator[*Subscription], error)
+
+	// AllTypedWithContext allows you to get all the subscriptions, through an iterator of *Subscription.
+	AllTypedWithContext(ctx context.Context, options ...SubscriptionAllParameters) (*TypedIterator[*Subscription], error)
+
+	// Create allows you to create a new subscription for the given customer.
+	Create(options ...SubscriptionCreateParameters) (*Subscription, error)
+
+	// CreateWithContext allows you to create a new subscription for the given customer.
+	CreateWithContext(ctx context.Context, options ...SubscriptionCreateParameters) (*Subscription, error)
+
+	// Find allows you to find a subscription by its ID.
+	Find(subscriptionID string, options ...SubscriptionFindParameters) (*Subscription, error)
+
+	// FindWithContext allows you to find a subscription by its ID.
+	FindWithContext(ctx context.Context, subscriptionID string, options ...SubscriptionFindParameters) (*Subscription, error)
+
+	// Save allows you to save the updated subscription attributes.
+	Save(options ...SubscriptionSaveParameters) (*Subscription, error)
+
+	// SaveWithContext allows you to save the updated subscription attributes.
+	SaveWithContext(ctx context.Context, options ...SubscriptionSaveParameters) (*Subscription, error)
+
+	// Cancel allows you to cancel a subscription. The reason may be provided as well.
+	Cancel(options ...SubscriptionCancelParameters) (*Subscription, error)
+
+	// CancelWithContext allows you to cancel a subscription. The reason may be provided as well.
+	CancelWithContext(ctx context.Context, options ...SubscriptionCancelParameters) (*Subscription, error)
+}
+
+var _ SubscriptionService = Subscription{}
+
+// Subscriptions returns the service of the Subscription resource, prefilled
+// with the given Subscription. It is equivalent to NewSubscription, but
+// returns an interface that can be mocked
+func (c *ProcessOut) Subscriptions(prefill ...*Subscription) SubscriptionService {
+	return c.NewSubscription(prefill...)
+}
+
+// TransactionService describes the operations available on a Transaction. It
+// is implemented by Transaction, and can be mocked using the processoutmock
+// package
+type TransactionService interface {
+	// FetchRefunds allows you to get the transaction's refunds.
+	FetchRefunds(options ...TransactionFetchRefundsParameters) (*Iterator, error)
+
+	// FetchRefundsWithContext allows you to get the transaction's refunds.
+	FetchRefundsWithContext(ctx context.Context, options ...TransactionFetchRefundsParameters) (*Iterator, error)
+
+	// FetchRefundsTyped allows you to get the transaction's refunds, through an iterator of *Refund.
+	FetchRefundsTyped(options ...TransactionFetchRefundsParameters) (*TypedIterator[*Refund], error)
+
+	// FetchRefundsTypedWithContext allows you to get the transaction's refunds, through an iterator of *Refund.
+	FetchRefundsTypedWithContext(ctx context.Context, options ...TransactionFetchRefundsParameters) (*TypedIterator[*Refund], error)
+
+	// FindRefund allows you to find a transaction's refund by its ID.
+	FindRefund(refundID string, options ...TransactionFindRefundParameters) (*Refund, error)
+
+	// FindRefundWithContext allows you to find a transaction's refund by its ID.
+	FindRefundWithContext(ctx context.Context, refundID string, options ...TransactionFindRefundParameters) (*Refund, error)
+
+	// All allows you to get all the transactions.
+	All(options ...TransactionAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the transactions.
+	AllWithContext(ctx context.Context, options ...TransactionAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the transactions, through an iterator of *Transaction.
+	AllTyped(options ...TransactionAllParameters) (*TypedIterator[*Transaction], error)
+
+	// AllTypedWithContext allows you to get all the transactions, through an iterator of *Transaction.
+	AllTypedWithContext(ctx context.Context, options ...TransactionAllParameters) (*TypedIterator[*Transaction], error)
+
+	// Find allows you to find a transaction by its ID.
+	Find(transactionID string, options ...TransactionFindParameters) (*Transaction, error)
+
+	// FindWithContext allows you to find a transaction by its ID.
+	FindWithContext(ctx context.Context, transactionID string, options ...TransactionFindParameters) (*Transaction, error)
+}
+
+var _ TransactionService = Transaction{}
+
+// Transactions returns the service of the Transaction resource, prefilled
+// with the given Transaction. It is equivalent to NewTransaction, but
+// returns an interface that can be mocked
+func (c *ProcessOut) Transactions(prefill ...*Transaction) TransactionService {
+	return c.NewTransaction(prefill...)
+}
+
+// WebhookService describes the operations available on a Webhook. It is
+// implemented by Webhook, and can be mocked using the processoutmock package
+type WebhookService interface {
+	// All allows you to get all the webhooks sent for the project.
+	All(options ...WebhookAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the webhooks sent for the project.
+	AllWithContext(ctx context.Context, options ...WebhookAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the webhooks sent for the project, through an iterator of *Webhook.
+	AllTyped(options ...WebhookAllParameters) (*TypedIterator[*Webhook], error)
+
+	// AllTypedWithContext allows you to get all the webhooks sent for the project, through an iterator of *Webhook.
+	AllTypedWithContext(ctx context.Context, options ...WebhookAllParameters) (*TypedIterator[*Webhook], error)
+
+	// Find allows you to find a webhook by its ID.
+	Find(webhookID string, options ...WebhookFindParameters) (*Webhook, error)
+
+	// FindWithContext allows you to find a webhook by its ID.
+	FindWithContext(ctx context.Context, webhookID string, options ...WebhookFindParameters) (*Webhook, error)
+}
+
+var _ WebhookService = Webhook{}
+
+// Webhooks returns the service of the Webhook resource, prefilled with the
+// given Webhook. It is equivalent to NewWebhook, but returns an interface
+// that can be mocked
+func (c *ProcessOut) Webhooks(prefill ...*Webhook) WebhookService {
+	return c.NewWebhook(prefill...)
+}
+
+// WebhookEndpointService describes the operations available on a
+// WebhookEndpoint. It is implemented by WebhookEndpoint, and can be mocked
+// using the processoutmock package
+type WebhookEndpointService interface {
+	// All allows you to get all the webhook endpoints of the project.
+	All(options ...WebhookEndpointAllParameters) (*Iterator, error)
+
+	// AllWithContext allows you to get all the webhook endpoints of the project.
+	AllWithContext(ctx context.Context, options ...WebhookEndpointAllParameters) (*Iterator, error)
+
+	// AllTyped allows you to get all the webhook endpoints of the project, through an iterator of *WebhookEndpoint.
+	AllTyped(options ...WebhookEndpointAllParameters) (*TypedIterator[*WebhookEndpoint], error)
+
+	// AllTypedWithContext allows you to get all the webhook endpoints of the project, through an iterator of *WebhookEndpoint.
+	AllTypedWithContext(ctx context.Context, options ...WebhookEndpointAllParameters) (*TypedIterator[*WebhookEndpoint], error)
+
+	// Create allows you to create a new webhook endpoint.
+	Create(options ...WebhookEndpointCreateParameters) (*WebhookEndpoint, error)
+
+	// CreateWithContext allows you to create a new webhook endpoint.
+	CreateWithContext(ctx context.Context, options ...WebhookEndpointCreateParameters) (*WebhookEndpoint, error)
+
+	// Find allows you to find a webhook endpoint by its ID.
+	Find(webhookEndpointID string, options ...WebhookEndpointFindParameters) (*WebhookEndpoint, error)
+
+	// FindWithContext allows you to find a webhook endpoint by its ID.
+	FindWithContext(ctx context.Context, webhookEndpointID string, options ...WebhookEndpointFindParameters) (*WebhookEndpoint, error)
+
+	// Save allows you to save the updated webhook endpoint attributes.
+	Save(options ...WebhookEndpointSaveParameters) (*WebhookEndpoint, error)
+
+	// SaveWithContext allows you to save the updated webhook endpoint attributes.
+	SaveWithContext(ctx context.Context, options ...WebhookEndpointSaveParameters) (*WebhookEndpoint, error)
+
+	// Delete allows you to delete the webhook endpoint.
+	Delete(options ...WebhookEndpointDeleteParameters) error
+
+	// DeleteWithContext allows you to delete the webhook endpoint.
+	DeleteWithContext(ctx context.Context, options ...WebhookEndpointDeleteParameters) error
+}
+
+var _ WebhookEndpointService = WebhookEndpoint{}
+
+// WebhookEndpoints returns the service of the WebhookEndpoint resource,
+// prefilled with the given WebhookEndpoint. It is equivalent to
+// NewWebhookEndpoint, but returns an interface that can be mocked
+func (c *ProcessOut) WebhookEndpoints(prefill ...*WebhookEndpoint) WebhookEndpointService {
+	return c.NewWebhookEndpoint(prefill...)
+}
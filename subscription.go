@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -174,6 +175,11 @@ type SubscriptionFetchAddonsParameters struct {
 
 // FetchAddons allows you to get the addons applied to the subscription.
 func (s Subscription) FetchAddons(options ...SubscriptionFetchAddonsParameters) (*Iterator, error) {
+	return s.FetchAddonsWithContext(context.Background(), options...)
+}
+
+// FetchAddonsWithContext allows you to get the addons applied to the subscription.
+func (s Subscription) FetchAddonsWithContext(ctx context.Context, options ...SubscriptionFetchAddonsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -212,9 +218,10 @@ func (s Subscription) FetchAddons(options ...SubscriptionFetchAddonsParameters)
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + "/addons"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -222,23 +229,31 @@ func (s Subscription) FetchAddons(options ...SubscriptionFetchAddonsParameters)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "FetchAddons",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -247,31 +262,53 @@ func (s Subscription) FetchAddons(options ...SubscriptionFetchAddonsParameters)
 	for _, o := range payload.Addons {
 		addonsList = append(addonsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	addonsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Subscription",
+			Method:     "FetchAddons",
+			ResourceID: *s.ID,
+		},
 		data:    addonsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"addons"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Addon `json:"addons"`
+				HasMore bool     `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return addonsIterator, nil
 }
 
+// FetchAddonsTyped allows you to get the addons applied to the subscription, through an iterator of *Addon.
+func (s Subscription) FetchAddonsTyped(options ...SubscriptionFetchAddonsParameters) (*TypedIterator[*Addon], error) {
+	return s.FetchAddonsTypedWithContext(context.Background(), options...)
+}
+
+// FetchAddonsTypedWithContext allows you to get the addons applied to the subscription, through an iterator of *Addon.
+func (s Subscription) FetchAddonsTypedWithContext(ctx context.Context, options ...SubscriptionFetchAddonsParameters) (*TypedIterator[*Addon], error) {
+	it, err := s.FetchAddonsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Addon]{Iterator: it}, nil
+}
+
 // SubscriptionFindAddonParameters is the structure representing the
 // additional parameters used to call Subscription.FindAddon
 type SubscriptionFindAddonParameters struct {
@@ -281,6 +318,11 @@ type SubscriptionFindAddonParameters struct {
 
 // FindAddon allows you to find a subscription's addon by its ID.
 func (s Subscription) FindAddon(addonID string, options ...SubscriptionFindAddonParameters) (*Addon, error) {
+	return s.FindAddonWithContext(context.Background(), addonID, options...)
+}
+
+// FindAddonWithContext allows you to find a subscription's addon by its ID.
+func (s Subscription) FindAddonWithContext(ctx context.Context, addonID string, options ...SubscriptionFindAddonParameters) (*Addon, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -318,9 +360,10 @@ func (s Subscription) FindAddon(addonID string, options ...SubscriptionFindAddon
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + "/addons/" + url.QueryEscape(addonID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -328,23 +371,31 @@ func (s Subscription) FindAddon(addonID string, options ...SubscriptionFindAddon
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "FindAddon",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -365,6 +416,11 @@ type SubscriptionDeleteAddonParameters struct {
 
 // DeleteAddon allows you to delete an addon applied to a subscription.
 func (s Subscription) DeleteAddon(addonID string, options ...SubscriptionDeleteAddonParameters) error {
+	return s.DeleteAddonWithContext(context.Background(), addonID, options...)
+}
+
+// DeleteAddonWithContext allows you to delete an addon applied to a subscription.
+func (s Subscription) DeleteAddonWithContext(ctx context.Context, addonID string, options ...SubscriptionDeleteAddonParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -407,9 +463,10 @@ func (s Subscription) DeleteAddon(addonID string, options ...SubscriptionDeleteA
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + "/addons/" + url.QueryEscape(addonID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -417,23 +474,31 @@ func (s Subscription) DeleteAddon(addonID string, options ...SubscriptionDeleteA
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "DeleteAddon",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -450,6 +515,11 @@ type SubscriptionFetchCustomerParameters struct {
 
 // FetchCustomer allows you to get the customer owning the subscription.
 func (s Subscription) FetchCustomer(options ...SubscriptionFetchCustomerParameters) (*Customer, error) {
+	return s.FetchCustomerWithContext(context.Background(), options...)
+}
+
+// FetchCustomerWithContext allows you to get the customer owning the subscription.
+func (s Subscription) FetchCustomerWithContext(ctx context.Context, options ...SubscriptionFetchCustomerParameters) (*Customer, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -487,9 +557,10 @@ func (s Subscription) FetchCustomer(options ...SubscriptionFetchCustomerParamete
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + "/customers"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -497,23 +568,31 @@ func (s Subscription) FetchCustomer(options ...SubscriptionFetchCustomerParamete
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "FetchCustomer",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -531,6 +610,11 @@ type SubscriptionFetchDiscountsParameters struct {
 
 // FetchDiscounts allows you to get the discounts applied to the subscription.
 func (s Subscription) FetchDiscounts(options ...SubscriptionFetchDiscountsParameters) (*Iterator, error) {
+	return s.FetchDiscountsWithContext(context.Background(), options...)
+}
+
+// FetchDiscountsWithContext allows you to get the discounts applied to the subscription.
+func (s Subscription) FetchDiscountsWithContext(ctx context.Context, options ...SubscriptionFetchDiscountsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -569,9 +653,10 @@ func (s Subscription) FetchDiscounts(options ...SubscriptionFetchDiscountsParame
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + "/discounts"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -579,23 +664,31 @@ func (s Subscription) FetchDiscounts(options ...SubscriptionFetchDiscountsParame
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "FetchDiscounts",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -604,31 +697,53 @@ func (s Subscription) FetchDiscounts(options ...SubscriptionFetchDiscountsParame
 	for _, o := range payload.Discounts {
 		discountsList = append(discountsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	discountsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Subscription",
+			Method:     "FetchDiscounts",
+			ResourceID: *s.ID,
+		},
 		data:    discountsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"discounts"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Discount `json:"discounts"`
+				HasMore bool        `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return discountsIterator, nil
 }
 
+// FetchDiscountsTyped allows you to get the discounts applied to the subscription, through an iterator of *Discount.
+func (s Subscription) FetchDiscountsTyped(options ...SubscriptionFetchDiscountsParameters) (*TypedIterator[*Discount], error) {
+	return s.FetchDiscountsTypedWithContext(context.Background(), options...)
+}
+
+// FetchDiscountsTypedWithContext allows you to get the discounts applied to the subscription, through an iterator of *Discount.
+func (s Subscription) FetchDiscountsTypedWithContext(ctx context.Context, options ...SubscriptionFetchDiscountsParameters) (*TypedIterator[*Discount], error) {
+	it, err := s.FetchDiscountsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Discount]{Iterator: it}, nil
+}
+
 // SubscriptionFindDiscountParameters is the structure representing the
 // additional parameters used to call Subscription.FindDiscount
 type SubscriptionFindDiscountParameters struct {
@@ -638,6 +753,11 @@ type SubscriptionFindDiscountParameters struct {
 
 // FindDiscount allows you to find a subscription's discount by its ID.
 func (s Subscription) FindDiscount(discountID string, options ...SubscriptionFindDiscountParameters) (*Discount, error) {
+	return s.FindDiscountWithContext(context.Background(), discountID, options...)
+}
+
+// FindDiscountWithContext allows you to find a subscription's discount by its ID.
+func (s Subscription) FindDiscountWithContext(ctx context.Context, discountID string, options ...SubscriptionFindDiscountParameters) (*Discount, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -675,9 +795,10 @@ func (s Subscription) FindDiscount(discountID string, options ...SubscriptionFin
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + "/discounts/" + url.QueryEscape(discountID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -685,23 +806,31 @@ func (s Subscription) FindDiscount(discountID string, options ...SubscriptionFin
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "FindDiscount",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -719,6 +848,11 @@ type SubscriptionDeleteDiscountParameters struct {
 
 // DeleteDiscount allows you to delete a discount applied to a subscription.
 func (s Subscription) DeleteDiscount(discountID string, options ...SubscriptionDeleteDiscountParameters) error {
+	return s.DeleteDiscountWithContext(context.Background(), discountID, options...)
+}
+
+// DeleteDiscountWithContext allows you to delete a discount applied to a subscription.
+func (s Subscription) DeleteDiscountWithContext(ctx context.Context, discountID string, options ...SubscriptionDeleteDiscountParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -755,9 +889,10 @@ func (s Subscription) DeleteDiscount(discountID string, options ...SubscriptionD
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + "/discounts/" + url.QueryEscape(discountID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -765,23 +900,31 @@ func (s Subscription) DeleteDiscount(discountID string, options ...SubscriptionD
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "DeleteDiscount",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -798,6 +941,11 @@ type SubscriptionFetchTransactionsParameters struct {
 
 // FetchTransactions allows you to get the subscriptions past transactions.
 func (s Subscription) FetchTransactions(options ...SubscriptionFetchTransactionsParameters) (*Iterator, error) {
+	return s.FetchTransactionsWithContext(context.Background(), options...)
+}
+
+// FetchTransactionsWithContext allows you to get the subscriptions past transactions.
+func (s Subscription) FetchTransactionsWithContext(ctx context.Context, options ...SubscriptionFetchTransactionsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -836,9 +984,10 @@ func (s Subscription) FetchTransactions(options ...SubscriptionFetchTransactions
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + "/transactions"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -846,23 +995,31 @@ func (s Subscription) FetchTransactions(options ...SubscriptionFetchTransactions
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "FetchTransactions",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -871,31 +1028,53 @@ func (s Subscription) FetchTransactions(options ...SubscriptionFetchTransactions
 	for _, o := range payload.Transactions {
 		transactionsList = append(transactionsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	transactionsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Subscription",
+			Method:     "FetchTransactions",
+			ResourceID: *s.ID,
+		},
 		data:    transactionsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"transactions"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Transaction `json:"transactions"`
+				HasMore bool           `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return transactionsIterator, nil
 }
 
+// FetchTransactionsTyped allows you to get the subscriptions past transactions, through an iterator of *Transaction.
+func (s Subscription) FetchTransactionsTyped(options ...SubscriptionFetchTransactionsParameters) (*TypedIterator[*Transaction], error) {
+	return s.FetchTransactionsTypedWithContext(context.Background(), options...)
+}
+
+// FetchTransactionsTypedWithContext allows you to get the subscriptions past transactions, through an iterator of *Transaction.
+func (s Subscription) FetchTransactionsTypedWithContext(ctx context.Context, options ...SubscriptionFetchTransactionsParameters) (*TypedIterator[*Transaction], error) {
+	it, err := s.FetchTransactionsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Transaction]{Iterator: it}, nil
+}
+
 // SubscriptionAllParameters is the structure representing the
 // additional parameters used to call Subscription.All
 type SubscriptionAllParameters struct {
@@ -905,6 +1084,11 @@ type SubscriptionAllParameters struct {
 
 // All allows you to get all the subscriptions.
 func (s Subscription) All(options ...SubscriptionAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the subscriptions.
+func (s Subscription) AllWithContext(ctx context.Context, options ...SubscriptionAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -943,9 +1127,10 @@ func (s Subscription) All(options ...SubscriptionAllParameters) (*Iterator, erro
 
 	path := "/subscriptions"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -953,23 +1138,30 @@ func (s Subscription) All(options ...SubscriptionAllParameters) (*Iterator, erro
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Subscription",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -978,31 +1170,52 @@ func (s Subscription) All(options ...SubscriptionAllParameters) (*Iterator, erro
 	for _, o := range payload.Subscriptions {
 		subscriptionsList = append(subscriptionsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	subscriptionsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Subscription",
+			Method:   "All",
+		},
 		data:    subscriptionsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"subscriptions"`
+				Data    []*Subscription `json:"subscriptions"`
 				HasMore bool            `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return subscriptionsIterator, nil
 }
 
+// AllTyped allows you to get all the subscriptions, through an iterator of *Subscription.
+func (s Subscription) AllTyped(options ...SubscriptionAllParameters) (*TypedIterator[*Subscription], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the subscriptions, through an iterator of *Subscription.
+func (s Subscription) AllTypedWithContext(ctx context.Context, options ...SubscriptionAllParameters) (*TypedIterator[*Subscription], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Subscription]{Iterator: it}, nil
+}
+
 // SubscriptionCreateParameters is the structure representing the
 // additional parameters used to call Subscription.Create
 type SubscriptionCreateParameters struct {
@@ -1014,6 +1227,11 @@ type SubscriptionCreateParameters struct {
 
 // Create allows you to create a new subscription for the given customer.
 func (s Subscription) Create(options ...SubscriptionCreateParameters) (*Subscription, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new subscription for the given customer.
+func (s Subscription) CreateWithContext(ctx context.Context, options ...SubscriptionCreateParameters) (*Subscription, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -1029,6 +1247,13 @@ func (s Subscription) Create(options ...SubscriptionCreateParameters) (*Subscrip
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Subscription)
+	if err := s.client.validate(Call{
+		Resource: "Subscription",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Subscription *Subscription `json:"subscription"`
@@ -1077,9 +1302,10 @@ func (s Subscription) Create(options ...SubscriptionCreateParameters) (*Subscrip
 
 	path := "/subscriptions"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -1087,23 +1313,30 @@ func (s Subscription) Create(options ...SubscriptionCreateParameters) (*Subscrip
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Subscription",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -1121,6 +1354,11 @@ type SubscriptionFindParameters struct {
 
 // Find allows you to find a subscription by its ID.
 func (s Subscription) Find(subscriptionID string, options ...SubscriptionFindParameters) (*Subscription, error) {
+	return s.FindWithContext(context.Background(), subscriptionID, options...)
+}
+
+// FindWithContext allows you to find a subscription by its ID.
+func (s Subscription) FindWithContext(ctx context.Context, subscriptionID string, options ...SubscriptionFindParameters) (*Subscription, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -1158,9 +1396,10 @@ func (s Subscription) Find(subscriptionID string, options ...SubscriptionFindPar
 
 	path := "/subscriptions/" + url.QueryEscape(subscriptionID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -1168,23 +1407,31 @@ func (s Subscription) Find(subscriptionID string, options ...SubscriptionFindPar
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "Find",
+		ResourceID: subscriptionID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -1207,6 +1454,11 @@ type SubscriptionSaveParameters struct {
 
 // Save allows you to save the updated subscription attributes.
 func (s Subscription) Save(options ...SubscriptionSaveParameters) (*Subscription, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated subscription attributes.
+func (s Subscription) SaveWithContext(ctx context.Context, options ...SubscriptionSaveParameters) (*Subscription, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -1222,6 +1474,14 @@ func (s Subscription) Save(options ...SubscriptionSaveParameters) (*Subscription
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Subscription)
+	if err := s.client.validate(Call{
+		Resource:   "Subscription",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Subscription *Subscription `json:"subscription"`
@@ -1266,9 +1526,10 @@ func (s Subscription) Save(options ...SubscriptionSaveParameters) (*Subscription
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -1276,23 +1537,31 @@ func (s Subscription) Save(options ...SubscriptionSaveParameters) (*Subscription
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -1311,6 +1580,11 @@ type SubscriptionCancelParameters struct {
 
 // Cancel allows you to cancel a subscription. The reason may be provided as well.
 func (s Subscription) Cancel(options ...SubscriptionCancelParameters) (*Subscription, error) {
+	return s.CancelWithContext(context.Background(), options...)
+}
+
+// CancelWithContext allows you to cancel a subscription. The reason may be provided as well.
+func (s Subscription) CancelWithContext(ctx context.Context, options ...SubscriptionCancelParameters) (*Subscription, error) {
 	if s.client == nil {
 		panic("Please use the client.NewSubscription() method to create a new Subscription object")
 	}
@@ -1354,9 +1628,10 @@ func (s Subscription) Cancel(options ...SubscriptionCancelParameters) (*Subscrip
 
 	path := "/subscriptions/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -1364,23 +1639,31 @@ func (s Subscription) Cancel(options ...SubscriptionCancelParameters) (*Subscrip
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Subscription",
+		Method:     "Cancel",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -1389,6 +1672,29 @@ func (s Subscription) Cancel(options ...SubscriptionCancelParameters) (*Subscrip
 	return payload.Subscription, nil
 }
 
+// AmountMoney returns the amount of the subscription in its currency
+func (s *Subscription) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, s.Currency)
+}
+
+// BillableAmountMoney returns the billable amount of the subscription in its
+// currency
+func (s *Subscription) BillableAmountMoney() (Money, error) {
+	return moneyOf(s.BillableAmount, s.Currency)
+}
+
+// DiscountedAmountMoney returns the discounted amount of the subscription in
+// its currency
+func (s *Subscription) DiscountedAmountMoney() (Money, error) {
+	return moneyOf(s.DiscountedAmount, s.Currency)
+}
+
+// AddonsAmountMoney returns the addons amount of the subscription in its
+// currency
+func (s *Subscription) AddonsAmountMoney() (Money, error) {
+	return moneyOf(s.AddonsAmount, s.Currency)
+}
+
 // dummySubscription is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
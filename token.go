@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -126,6 +127,11 @@ type TokenFetchCustomerTokensParameters struct {
 
 // FetchCustomerTokens allows you to get the customer's tokens.
 func (s Token) FetchCustomerTokens(customerID string, options ...TokenFetchCustomerTokensParameters) (*Iterator, error) {
+	return s.FetchCustomerTokensWithContext(context.Background(), customerID, options...)
+}
+
+// FetchCustomerTokensWithContext allows you to get the customer's tokens.
+func (s Token) FetchCustomerTokensWithContext(ctx context.Context, customerID string, options ...TokenFetchCustomerTokensParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewToken() method to create a new Token object")
 	}
@@ -164,9 +170,10 @@ func (s Token) FetchCustomerTokens(customerID string, options ...TokenFetchCusto
 
 	path := "/customers/" + url.QueryEscape(customerID) + "/tokens"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -174,23 +181,30 @@ func (s Token) FetchCustomerTokens(customerID string, options ...TokenFetchCusto
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Token",
+		Method:   "FetchCustomerTokens",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -199,31 +213,52 @@ func (s Token) FetchCustomerTokens(customerID string, options ...TokenFetchCusto
 	for _, o := range payload.Tokens {
 		tokensList = append(tokensList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	tokensIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Token",
+			Method:   "FetchCustomerTokens",
+		},
 		data:    tokensList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"tokens"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Token `json:"tokens"`
+				HasMore bool     `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return tokensIterator, nil
 }
 
+// FetchCustomerTokensTyped allows you to get the customer's tokens, through an iterator of *Token.
+func (s Token) FetchCustomerTokensTyped(customerID string, options ...TokenFetchCustomerTokensParameters) (*TypedIterator[*Token], error) {
+	return s.FetchCustomerTokensTypedWithContext(context.Background(), customerID, options...)
+}
+
+// FetchCustomerTokensTypedWithContext allows you to get the customer's tokens, through an iterator of *Token.
+func (s Token) FetchCustomerTokensTypedWithContext(ctx context.Context, customerID string, options ...TokenFetchCustomerTokensParameters) (*TypedIterator[*Token], error) {
+	it, err := s.FetchCustomerTokensWithContext(ctx, customerID, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Token]{Iterator: it}, nil
+}
+
 // TokenFindParameters is the structure representing the
 // additional parameters used to call Token.Find
 type TokenFindParameters struct {
@@ -233,6 +268,11 @@ type TokenFindParameters struct {
 
 // Find allows you to find a customer's token by its ID.
 func (s Token) Find(customerID, tokenID string, options ...TokenFindParameters) (*Token, error) {
+	return s.FindWithContext(context.Background(), customerID, tokenID, options...)
+}
+
+// FindWithContext allows you to find a customer's token by its ID.
+func (s Token) FindWithContext(ctx context.Context, customerID, tokenID string, options ...TokenFindParameters) (*Token, error) {
 	if s.client == nil {
 		panic("Please use the client.NewToken() method to create a new Token object")
 	}
@@ -270,9 +310,10 @@ func (s Token) Find(customerID, tokenID string, options ...TokenFindParameters)
 
 	path := "/customers/" + url.QueryEscape(customerID) + "/tokens/" + url.QueryEscape(tokenID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -280,23 +321,31 @@ func (s Token) Find(customerID, tokenID string, options ...TokenFindParameters)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Token",
+		Method:     "Find",
+		ResourceID: tokenID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -320,6 +369,11 @@ type TokenCreateParameters struct {
 
 // Create allows you to create a new token for the given customer ID.
 func (s Token) Create(options ...TokenCreateParameters) (*Token, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new token for the given customer ID.
+func (s Token) CreateWithContext(ctx context.Context, options ...TokenCreateParameters) (*Token, error) {
 	if s.client == nil {
 		panic("Please use the client.NewToken() method to create a new Token object")
 	}
@@ -335,6 +389,13 @@ func (s Token) Create(options ...TokenCreateParameters) (*Token, error) {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Token)
+	if err := s.client.validate(Call{
+		Resource: "Token",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
 
 	type Response struct {
 		Token   *Token `json:"token"`
@@ -377,9 +438,10 @@ func (s Token) Create(options ...TokenCreateParameters) (*Token, error) {
 
 	path := "/customers/" + url.QueryEscape(*s.CustomerID) + "/tokens"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -387,23 +449,30 @@ func (s Token) Create(options ...TokenCreateParameters) (*Token, error) {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Token",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -427,6 +496,11 @@ type TokenSaveParameters struct {
 
 // Save allows you to save the updated customer attributes.
 func (s Token) Save(options ...TokenSaveParameters) error {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated customer attributes.
+func (s Token) SaveWithContext(ctx context.Context, options ...TokenSaveParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewToken() method to create a new Token object")
 	}
@@ -442,6 +516,14 @@ func (s Token) Save(options ...TokenSaveParameters) error {
 		opt.Options = &Options{}
 	}
 	s.Prefill(opt.Token)
+	if err := s.client.validate(Call{
+		Resource:   "Token",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return err
+	}
 
 	type Response struct {
 		HasMore bool   `json:"has_more"`
@@ -475,9 +557,10 @@ func (s Token) Save(options ...TokenSaveParameters) error {
 
 	path := "/customers/" + url.QueryEscape(*s.CustomerID) + "/tokens/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -485,23 +568,31 @@ func (s Token) Save(options ...TokenSaveParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Token",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
@@ -518,6 +609,11 @@ type TokenDeleteParameters struct {
 
 // Delete allows you to delete a customer token
 func (s Token) Delete(options ...TokenDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete a customer token
+func (s Token) DeleteWithContext(ctx context.Context, options ...TokenDeleteParameters) error {
 	if s.client == nil {
 		panic("Please use the client.NewToken() method to create a new Token object")
 	}
@@ -554,9 +650,10 @@ func (s Token) Delete(options ...TokenDeleteParameters) error {
 
 	path := "/customers/" + url.QueryEscape(*s.CustomerID) + "/tokens/" + url.QueryEscape(*s.ID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -564,23 +661,31 @@ func (s Token) Delete(options ...TokenDeleteParameters) error {
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Token",
+		Method:     "Delete",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return errors.New(err, "", "")
+		return errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return erri
 	}
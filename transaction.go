@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -234,6 +235,16 @@ func (s *Transaction) Prefill(c *Transaction) *Transaction {
 	return s
 }
 
+// ErrorClass returns the class of the ErrorCode of the transaction, telling
+// whether or not the payment may be retried
+func (s *Transaction) ErrorClass() errors.Class {
+	if s.ErrorCode == nil {
+		return errors.ClassUnknown
+	}
+
+	return errors.Classify(*s.ErrorCode)
+}
+
 // TransactionFetchRefundsParameters is the structure representing the
 // additional parameters used to call Transaction.FetchRefunds
 type TransactionFetchRefundsParameters struct {
@@ -243,6 +254,11 @@ type TransactionFetchRefundsParameters struct {
 
 // FetchRefunds allows you to get the transaction's refunds.
 func (s Transaction) FetchRefunds(options ...TransactionFetchRefundsParameters) (*Iterator, error) {
+	return s.FetchRefundsWithContext(context.Background(), options...)
+}
+
+// FetchRefundsWithContext allows you to get the transaction's refunds.
+func (s Transaction) FetchRefundsWithContext(ctx context.Context, options ...TransactionFetchRefundsParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewTransaction() method to create a new Transaction object")
 	}
@@ -281,9 +297,10 @@ func (s Transaction) FetchRefunds(options ...TransactionFetchRefundsParameters)
 
 	path := "/transactions/" + url.QueryEscape(*s.ID) + "/refunds"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -291,23 +308,31 @@ func (s Transaction) FetchRefunds(options ...TransactionFetchRefundsParameters)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Transaction",
+		Method:     "FetchRefunds",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -316,31 +341,53 @@ func (s Transaction) FetchRefunds(options ...TransactionFetchRefundsParameters)
 	for _, o := range payload.Refunds {
 		refundsList = append(refundsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	refundsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource:   "Transaction",
+			Method:     "FetchRefunds",
+			ResourceID: *s.ID,
+		},
 		data:    refundsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"refunds"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Refund `json:"refunds"`
+				HasMore bool      `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return refundsIterator, nil
 }
 
+// FetchRefundsTyped allows you to get the transaction's refunds, through an iterator of *Refund.
+func (s Transaction) FetchRefundsTyped(options ...TransactionFetchRefundsParameters) (*TypedIterator[*Refund], error) {
+	return s.FetchRefundsTypedWithContext(context.Background(), options...)
+}
+
+// FetchRefundsTypedWithContext allows you to get the transaction's refunds, through an iterator of *Refund.
+func (s Transaction) FetchRefundsTypedWithContext(ctx context.Context, options ...TransactionFetchRefundsParameters) (*TypedIterator[*Refund], error) {
+	it, err := s.FetchRefundsWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Refund]{Iterator: it}, nil
+}
+
 // TransactionFindRefundParameters is the structure representing the
 // additional parameters used to call Transaction.FindRefund
 type TransactionFindRefundParameters struct {
@@ -350,6 +397,11 @@ type TransactionFindRefundParameters struct {
 
 // FindRefund allows you to find a transaction's refund by its ID.
 func (s Transaction) FindRefund(refundID string, options ...TransactionFindRefundParameters) (*Refund, error) {
+	return s.FindRefundWithContext(context.Background(), refundID, options...)
+}
+
+// FindRefundWithContext allows you to find a transaction's refund by its ID.
+func (s Transaction) FindRefundWithContext(ctx context.Context, refundID string, options ...TransactionFindRefundParameters) (*Refund, error) {
 	if s.client == nil {
 		panic("Please use the client.NewTransaction() method to create a new Transaction object")
 	}
@@ -387,9 +439,10 @@ func (s Transaction) FindRefund(refundID string, options ...TransactionFindRefun
 
 	path := "/transactions/" + url.QueryEscape(*s.ID) + "/refunds/" + url.QueryEscape(refundID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -397,23 +450,31 @@ func (s Transaction) FindRefund(refundID string, options ...TransactionFindRefun
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Transaction",
+		Method:     "FindRefund",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -431,6 +492,11 @@ type TransactionAllParameters struct {
 
 // All allows you to get all the transactions.
 func (s Transaction) All(options ...TransactionAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the transactions.
+func (s Transaction) AllWithContext(ctx context.Context, options ...TransactionAllParameters) (*Iterator, error) {
 	if s.client == nil {
 		panic("Please use the client.NewTransaction() method to create a new Transaction object")
 	}
@@ -469,9 +535,10 @@ func (s Transaction) All(options ...TransactionAllParameters) (*Iterator, error)
 
 	path := "/transactions"
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -479,23 +546,30 @@ func (s Transaction) All(options ...TransactionAllParameters) (*Iterator, error)
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource: "Transaction",
+		Method:   "All",
+		Options:  opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -504,31 +578,52 @@ func (s Transaction) All(options ...TransactionAllParameters) (*Iterator, error)
 	for _, o := range payload.Transactions {
 		transactionsList = append(transactionsList, o.SetClient(s.client))
 	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
 	transactionsIterator := &Iterator{
-		pos:     -1,
-		path:    path,
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Transaction",
+			Method:   "All",
+		},
 		data:    transactionsList,
 		options: opt.Options,
-		decoder: func(b io.Reader, i interface{}) (bool, error) {
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
 			r := struct {
-				Data    json.RawMessage `json:"transactions"`
-				HasMore bool            `json:"has_more"`
+				Data    []*Transaction `json:"transactions"`
+				HasMore bool           `json:"has_more"`
 			}{}
-			if err := json.NewDecoder(b).Decode(&r); err != nil {
-				return false, err
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
 			}
-			if err := json.Unmarshal(r.Data, i); err != nil {
-				return false, err
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
 			}
-			return r.HasMore, nil
+			return l, r.HasMore, nil
 		},
 		client:      s.client,
-		hasMoreNext: payload.HasMore,
-		hasMorePrev: false,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
 	}
 	return transactionsIterator, nil
 }
 
+// AllTyped allows you to get all the transactions, through an iterator of *Transaction.
+func (s Transaction) AllTyped(options ...TransactionAllParameters) (*TypedIterator[*Transaction], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the transactions, through an iterator of *Transaction.
+func (s Transaction) AllTypedWithContext(ctx context.Context, options ...TransactionAllParameters) (*TypedIterator[*Transaction], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Transaction]{Iterator: it}, nil
+}
+
 // TransactionFindParameters is the structure representing the
 // additional parameters used to call Transaction.Find
 type TransactionFindParameters struct {
@@ -538,6 +633,11 @@ type TransactionFindParameters struct {
 
 // Find allows you to find a transaction by its ID.
 func (s Transaction) Find(transactionID string, options ...TransactionFindParameters) (*Transaction, error) {
+	return s.FindWithContext(context.Background(), transactionID, options...)
+}
+
+// FindWithContext allows you to find a transaction by its ID.
+func (s Transaction) FindWithContext(ctx context.Context, transactionID string, options ...TransactionFindParameters) (*Transaction, error) {
 	if s.client == nil {
 		panic("Please use the client.NewTransaction() method to create a new Transaction object")
 	}
@@ -575,9 +675,10 @@ func (s Transaction) Find(transactionID string, options ...TransactionFindParame
 
 	path := "/transactions/" + url.QueryEscape(transactionID) + ""
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		Host+path,
+		s.client.baseURL()+path,
 		bytes.NewReader(body),
 	)
 	if err != nil {
@@ -585,23 +686,31 @@ func (s Transaction) Find(transactionID string, options ...TransactionFindParame
 	}
 	setupRequest(s.client, opt.Options, req)
 
-	res, err := s.client.HTTPClient.Do(req)
+	res, err := s.client.do(req, Call{
+		Resource:   "Transaction",
+		Method:     "Find",
+		ResourceID: transactionID,
+		Options:    opt.Options,
+	})
 	if err != nil {
 		return nil, errors.NewNetworkError(err)
 	}
 	payload := &Response{}
 	defer res.Body.Close()
-	if res.StatusCode >= 500 {
-		return nil, errors.New(nil, "", "An unexpected error occurred while processing your request.. A lot of sweat is already flowing from our developers head!")
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
-	err = json.NewDecoder(res.Body).Decode(payload)
+	err = json.Unmarshal(resBody, payload)
 	if err != nil {
-		return nil, errors.New(err, "", "")
+		return nil, errors.NewFromHTTPResponse(res, resBody)
 	}
 
 	if !payload.Success {
-		erri := errors.NewFromResponse(res.StatusCode, payload.Code,
-			payload.Message)
+		erri := errors.NewFromHTTPResponse(res, resBody)
 
 		return nil, erri
 	}
@@ -610,6 +719,129 @@ func (s Transaction) Find(transactionID string, options ...TransactionFindParame
 	return payload.Transaction, nil
 }
 
+// AmountMoney returns the amount of the transaction in its currency
+func (s *Transaction) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, s.Currency)
+}
+
+// AuthorizedAmountMoney returns the authorized amount of the transaction in
+// its currency
+func (s *Transaction) AuthorizedAmountMoney() (Money, error) {
+	return moneyOf(s.AuthorizedAmount, s.Currency)
+}
+
+// CapturedAmountMoney returns the captured amount of the transaction in its
+// currency
+func (s *Transaction) CapturedAmountMoney() (Money, error) {
+	return moneyOf(s.CapturedAmount, s.Currency)
+}
+
+// RefundedAmountMoney returns the refunded amount of the transaction in its
+// currency
+func (s *Transaction) RefundedAmountMoney() (Money, error) {
+	return moneyOf(s.RefundedAmount, s.Currency)
+}
+
+// AvailableAmountMoney returns the available amount of the transaction in
+// its currency
+func (s *Transaction) AvailableAmountMoney() (Money, error) {
+	return moneyOf(s.AvailableAmount, s.Currency)
+}
+
+// AmountLocalMoney returns the amount of the transaction in the default
+// currency of its project, which is empty unless Project was expanded
+func (s *Transaction) AmountLocalMoney() (Money, error) {
+	var currency *string
+	if s.Project != nil {
+		currency = s.Project.DefaultCurrency
+	}
+
+	return moneyOf(s.AmountLocal, currency)
+}
+
+// AuthorizedAmountLocalMoney returns the authorized amount of the
+// transaction in the default currency of its project, which is empty unless
+// Project was expanded
+func (s *Transaction) AuthorizedAmountLocalMoney() (Money, error) {
+	var currency *string
+	if s.Project != nil {
+		currency = s.Project.DefaultCurrency
+	}
+
+	return moneyOf(s.AuthorizedAmountLocal, currency)
+}
+
+// CapturedAmountLocalMoney returns the captured amount of the transaction in
+// the default currency of its project, which is empty unless Project was
+// expanded
+func (s *Transaction) CapturedAmountLocalMoney() (Money, error) {
+	var currency *string
+	if s.Project != nil {
+		currency = s.Project.DefaultCurrency
+	}
+
+	return moneyOf(s.CapturedAmountLocal, currency)
+}
+
+// RefundedAmountLocalMoney returns the refunded amount of the transaction in
+// the default currency of its project, which is empty unless Project was
+// expanded
+func (s *Transaction) RefundedAmountLocalMoney() (Money, error) {
+	var currency *string
+	if s.Project != nil {
+		currency = s.Project.DefaultCurrency
+	}
+
+	return moneyOf(s.RefundedAmountLocal, currency)
+}
+
+// AvailableAmountLocalMoney returns the available amount of the transaction
+// in the default currency of its project, which is empty unless Project was
+// expanded
+func (s *Transaction) AvailableAmountLocalMoney() (Money, error) {
+	var currency *string
+	if s.Project != nil {
+		currency = s.Project.DefaultCurrency
+	}
+
+	return moneyOf(s.AvailableAmountLocal, currency)
+}
+
+// ProcessoutFeeMoney returns the ProcessOut fee of the transaction in its
+// currency
+func (s *Transaction) ProcessoutFeeMoney() (Money, error) {
+	return moneyOf(s.ProcessoutFee, s.Currency)
+}
+
+// EstimatedFeeMoney returns the estimated fee of the transaction in its
+// currency
+func (s *Transaction) EstimatedFeeMoney() (Money, error) {
+	return moneyOf(s.EstimatedFee, s.Currency)
+}
+
+// GatewayFeeMoney returns the gateway fee of the transaction in its currency
+func (s *Transaction) GatewayFeeMoney() (Money, error) {
+	return moneyOf(s.GatewayFee, s.Currency)
+}
+
+// CurrencyFeeMoney returns the currency fee of the transaction in its
+// currency
+func (s *Transaction) CurrencyFeeMoney() (Money, error) {
+	return moneyOf(s.CurrencyFee, s.Currency)
+}
+
+// GatewayFeeLocalMoney returns the gateway fee of the transaction in the
+// default currency of its project, which is empty unless Project was
+// expanded
+func (s *Transaction) GatewayFeeLocalMoney() (Money, error) {
+	var currency *string
+	if s.Project != nil {
+		currency = s.Project.DefaultCurrency
+	}
+
+	return moneyOf(s.GatewayFeeLocal, currency)
+}
+
 // dummyTransaction is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
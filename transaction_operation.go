@@ -144,6 +144,28 @@ func (s *TransactionOperation) Prefill(c *TransactionOperation) *TransactionOper
 	return s
 }
 
+// ErrorClass returns the class of the ErrorCode of the operation, telling
+// whether or not the payment may be retried
+func (s *TransactionOperation) ErrorClass() errors.Class {
+	if s.ErrorCode == nil {
+		return errors.ClassUnknown
+	}
+
+	return errors.Classify(*s.ErrorCode)
+}
+
+// AmountMoney returns the amount of the transaction operation in its
+// currency
+func (s *TransactionOperation) AmountMoney() (Money, error) {
+	return moneyOf(s.Amount, s.Currency)
+}
+
+// GatewayFeeMoney returns the gateway fee of the transaction operation in
+// its currency
+func (s *TransactionOperation) GatewayFeeMoney() (Money, error) {
+	return moneyOf(s.GatewayFee, s.Currency)
+}
+
 // dummyTransactionOperation is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -0,0 +1,37 @@
+package processout
+
+// Validator is implemented by the resources checking their own fields, such
+// as their currency, before being sent by their Create and Save methods. It
+// is only called when EnableValidation is set on the client
+type Validator interface {
+	Validate() error
+}
+
+// ValidateFunc is a hook validating a resource before it is sent by the
+// given Create or Save call, such as Invoice.Create. The resource is a
+// pointer to the resource, such as *Invoice. Returning an error aborts the
+// call, and the error is returned by the resource method
+type ValidateFunc func(call *Call, resource interface{}) error
+
+// UseValidators appends the given hooks to the validators of the client
+func (c *ProcessOut) UseValidators(validators ...ValidateFunc) {
+	c.Validators = append(c.Validators, validators...)
+}
+
+// validate validates the resource before it is sent by the given call,
+// first with its own Validate method if enabled and then with the client
+// validators
+func (c *ProcessOut) validate(call Call, resource interface{}) error {
+	if v, ok := resource.(Validator); ok && c.EnableValidation {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, validate := range c.Validators {
+		if err := validate(&call, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
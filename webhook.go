@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -96,6 +97,242 @@ func (s *Webhook) Prefill(c *Webhook) *Webhook {
 	return s
 }
 
+// WebhookAllParameters is the structure representing the
+// additional parameters used to call Webhook.All
+type WebhookAllParameters struct {
+	*Options
+	*Webhook
+}
+
+// All allows you to get all the webhooks sent for the project.
+func (s Webhook) All(options ...WebhookAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the webhooks sent for the project.
+func (s Webhook) AllWithContext(ctx context.Context, options ...WebhookAllParameters) (*Iterator, error) {
+	if s.client == nil {
+		panic("Please use the client.NewWebhook() method to create a new Webhook object")
+	}
+	if len(options) > 1 {
+		panic("The options parameter should only be provided once.")
+	}
+
+	opt := WebhookAllParameters{}
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	if opt.Options == nil {
+		opt.Options = &Options{}
+	}
+	s.Prefill(opt.Webhook)
+
+	type Response struct {
+		Webhooks []*Webhook `json:"webhooks"`
+
+		HasMore bool   `json:"has_more"`
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+		Code    string `json:"error_type"`
+	}
+
+	data := struct {
+		*Options
+	}{
+		Options: opt.Options,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	path := "/webhooks"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		s.client.baseURL()+path,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	setupRequest(s.client, opt.Options, req)
+
+	res, err := s.client.do(req, Call{
+		Resource: "Webhook",
+		Method:   "All",
+		Options:  opt.Options,
+	})
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	payload := &Response{}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
+	if err != nil {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+
+	if !payload.Success {
+		erri := errors.NewFromHTTPResponse(res, resBody)
+
+		return nil, erri
+	}
+
+	webhooksList := []Identifiable{}
+	for _, o := range payload.Webhooks {
+		webhooksList = append(webhooksList, o.SetClient(s.client))
+	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
+	webhooksIterator := &Iterator{
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "Webhook",
+			Method:   "All",
+		},
+		data:    webhooksList,
+		options: opt.Options,
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
+			r := struct {
+				Data    []*Webhook `json:"webhooks"`
+				HasMore bool       `json:"has_more"`
+			}{}
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
+			}
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
+			}
+			return l, r.HasMore, nil
+		},
+		client:      s.client,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
+	}
+	return webhooksIterator, nil
+}
+
+// AllTyped allows you to get all the webhooks sent for the project, through an iterator of *Webhook.
+func (s Webhook) AllTyped(options ...WebhookAllParameters) (*TypedIterator[*Webhook], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the webhooks sent for the project, through an iterator of *Webhook.
+func (s Webhook) AllTypedWithContext(ctx context.Context, options ...WebhookAllParameters) (*TypedIterator[*Webhook], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*Webhook]{Iterator: it}, nil
+}
+
+// WebhookFindParameters is the structure representing the
+// additional parameters used to call Webhook.Find
+type WebhookFindParameters struct {
+	*Options
+	*Webhook
+}
+
+// Find allows you to find a webhook by its ID.
+func (s Webhook) Find(webhookID string, options ...WebhookFindParameters) (*Webhook, error) {
+	return s.FindWithContext(context.Background(), webhookID, options...)
+}
+
+// FindWithContext allows you to find a webhook by its ID.
+func (s Webhook) FindWithContext(ctx context.Context, webhookID string, options ...WebhookFindParameters) (*Webhook, error) {
+	if s.client == nil {
+		panic("Please use the client.NewWebhook() method to create a new Webhook object")
+	}
+	if len(options) > 1 {
+		panic("The options parameter should only be provided once.")
+	}
+
+	opt := WebhookFindParameters{}
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	if opt.Options == nil {
+		opt.Options = &Options{}
+	}
+	s.Prefill(opt.Webhook)
+
+	type Response struct {
+		Webhook *Webhook `json:"webhook"`
+		HasMore bool     `json:"has_more"`
+		Success bool     `json:"success"`
+		Message string   `json:"message"`
+		Code    string   `json:"error_type"`
+	}
+
+	data := struct {
+		*Options
+	}{
+		Options: opt.Options,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	path := "/webhooks/" + url.QueryEscape(webhookID) + ""
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		s.client.baseURL()+path,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	setupRequest(s.client, opt.Options, req)
+
+	res, err := s.client.do(req, Call{
+		Resource:   "Webhook",
+		Method:     "Find",
+		ResourceID: webhookID,
+		Options:    opt.Options,
+	})
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	payload := &Response{}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
+	if err != nil {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+
+	if !payload.Success {
+		erri := errors.NewFromHTTPResponse(res, resBody)
+
+		return nil, erri
+	}
+
+	payload.Webhook.SetClient(s.client)
+	return payload.Webhook, nil
+}
+
 // dummyWebhook is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -2,6 +2,7 @@ package processout
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -22,8 +23,8 @@ type WebhookEndpoint struct {
 	ProjectID *string `json:"project_id,omitempty"`
 	// URL is the uRL to which the webhook endpoint points to
 	URL *string `json:"url,omitempty"`
-	// EventsWhitelist is the slice of string representing the whitelisted events posted to the endpoint
-	EventsWhitelist interface{} `json:"events_whitelist,omitempty"`
+	// EventsWhitelist is the list of the events posted to the endpoint. All the events are posted when empty
+	EventsWhitelist *[]string `json:"events_whitelist,omitempty"`
 	// Sandbox is the define whether or not the webhook endpoint is in sandbox environment
 	Sandbox *bool `json:"sandbox,omitempty"`
 	// CreatedAt is the date at which the webhook endpoint was created
@@ -72,6 +73,547 @@ func (s *WebhookEndpoint) Prefill(c *WebhookEndpoint) *WebhookEndpoint {
 	return s
 }
 
+// WebhookEndpointAllParameters is the structure representing the
+// additional parameters used to call WebhookEndpoint.All
+type WebhookEndpointAllParameters struct {
+	*Options
+	*WebhookEndpoint
+}
+
+// All allows you to get all the webhook endpoints of the project.
+func (s WebhookEndpoint) All(options ...WebhookEndpointAllParameters) (*Iterator, error) {
+	return s.AllWithContext(context.Background(), options...)
+}
+
+// AllWithContext allows you to get all the webhook endpoints of the project.
+func (s WebhookEndpoint) AllWithContext(ctx context.Context, options ...WebhookEndpointAllParameters) (*Iterator, error) {
+	if s.client == nil {
+		panic("Please use the client.NewWebhookEndpoint() method to create a new WebhookEndpoint object")
+	}
+	if len(options) > 1 {
+		panic("The options parameter should only be provided once.")
+	}
+
+	opt := WebhookEndpointAllParameters{}
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	if opt.Options == nil {
+		opt.Options = &Options{}
+	}
+	s.Prefill(opt.WebhookEndpoint)
+
+	type Response struct {
+		WebhookEndpoints []*WebhookEndpoint `json:"webhook_endpoints"`
+
+		HasMore bool   `json:"has_more"`
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+		Code    string `json:"error_type"`
+	}
+
+	data := struct {
+		*Options
+	}{
+		Options: opt.Options,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	path := "/webhook-endpoints"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		s.client.baseURL()+path,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	setupRequest(s.client, opt.Options, req)
+
+	res, err := s.client.do(req, Call{
+		Resource: "WebhookEndpoint",
+		Method:   "All",
+		Options:  opt.Options,
+	})
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	payload := &Response{}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
+	if err != nil {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+
+	if !payload.Success {
+		erri := errors.NewFromHTTPResponse(res, resBody)
+
+		return nil, erri
+	}
+
+	webhookEndpointsList := []Identifiable{}
+	for _, o := range payload.WebhookEndpoints {
+		webhookEndpointsList = append(webhookEndpointsList, o.SetClient(s.client))
+	}
+	hasMoreNext, hasMorePrev := pageHasMore(opt.Options, payload.HasMore)
+	webhookEndpointsIterator := &Iterator{
+		pos:  -1,
+		path: path,
+		call: Call{
+			Resource: "WebhookEndpoint",
+			Method:   "All",
+		},
+		data:    webhookEndpointsList,
+		options: opt.Options,
+		decoder: func(b []byte) ([]Identifiable, bool, error) {
+			r := struct {
+				Data    []*WebhookEndpoint `json:"webhook_endpoints"`
+				HasMore bool               `json:"has_more"`
+			}{}
+			if err := json.Unmarshal(b, &r); err != nil {
+				return nil, false, err
+			}
+			l := []Identifiable{}
+			for _, o := range r.Data {
+				l = append(l, o.SetClient(s.client))
+			}
+			return l, r.HasMore, nil
+		},
+		client:      s.client,
+		hasMoreNext: hasMoreNext,
+		hasMorePrev: hasMorePrev,
+	}
+	return webhookEndpointsIterator, nil
+}
+
+// AllTyped allows you to get all the webhook endpoints of the project, through an iterator of *WebhookEndpoint.
+func (s WebhookEndpoint) AllTyped(options ...WebhookEndpointAllParameters) (*TypedIterator[*WebhookEndpoint], error) {
+	return s.AllTypedWithContext(context.Background(), options...)
+}
+
+// AllTypedWithContext allows you to get all the webhook endpoints of the project, through an iterator of *WebhookEndpoint.
+func (s WebhookEndpoint) AllTypedWithContext(ctx context.Context, options ...WebhookEndpointAllParameters) (*TypedIterator[*WebhookEndpoint], error) {
+	it, err := s.AllWithContext(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedIterator[*WebhookEndpoint]{Iterator: it}, nil
+}
+
+// WebhookEndpointCreateParameters is the structure representing the
+// additional parameters used to call WebhookEndpoint.Create
+type WebhookEndpointCreateParameters struct {
+	*Options
+	*WebhookEndpoint
+}
+
+// Create allows you to create a new webhook endpoint.
+func (s WebhookEndpoint) Create(options ...WebhookEndpointCreateParameters) (*WebhookEndpoint, error) {
+	return s.CreateWithContext(context.Background(), options...)
+}
+
+// CreateWithContext allows you to create a new webhook endpoint.
+func (s WebhookEndpoint) CreateWithContext(ctx context.Context, options ...WebhookEndpointCreateParameters) (*WebhookEndpoint, error) {
+	if s.client == nil {
+		panic("Please use the client.NewWebhookEndpoint() method to create a new WebhookEndpoint object")
+	}
+	if len(options) > 1 {
+		panic("The options parameter should only be provided once.")
+	}
+
+	opt := WebhookEndpointCreateParameters{}
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	if opt.Options == nil {
+		opt.Options = &Options{}
+	}
+	s.Prefill(opt.WebhookEndpoint)
+	if err := s.client.validate(Call{
+		Resource: "WebhookEndpoint",
+		Method:   "Create",
+		Options:  opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
+
+	type Response struct {
+		WebhookEndpoint *WebhookEndpoint `json:"webhook_endpoint"`
+		HasMore         bool             `json:"has_more"`
+		Success         bool             `json:"success"`
+		Message         string           `json:"message"`
+		Code            string           `json:"error_type"`
+	}
+
+	data := struct {
+		*Options
+		URL             interface{} `json:"url"`
+		EventsWhitelist interface{} `json:"events_whitelist"`
+	}{
+		Options:         opt.Options,
+		URL:             s.URL,
+		EventsWhitelist: s.EventsWhitelist,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	path := "/webhook-endpoints"
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		s.client.baseURL()+path,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	setupRequest(s.client, opt.Options, req)
+
+	res, err := s.client.do(req, Call{
+		Resource: "WebhookEndpoint",
+		Method:   "Create",
+		Options:  opt.Options,
+	})
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	payload := &Response{}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
+	if err != nil {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+
+	if !payload.Success {
+		erri := errors.NewFromHTTPResponse(res, resBody)
+
+		return nil, erri
+	}
+
+	payload.WebhookEndpoint.SetClient(s.client)
+	return payload.WebhookEndpoint, nil
+}
+
+// WebhookEndpointFindParameters is the structure representing the
+// additional parameters used to call WebhookEndpoint.Find
+type WebhookEndpointFindParameters struct {
+	*Options
+	*WebhookEndpoint
+}
+
+// Find allows you to find a webhook endpoint by its ID.
+func (s WebhookEndpoint) Find(webhookEndpointID string, options ...WebhookEndpointFindParameters) (*WebhookEndpoint, error) {
+	return s.FindWithContext(context.Background(), webhookEndpointID, options...)
+}
+
+// FindWithContext allows you to find a webhook endpoint by its ID.
+func (s WebhookEndpoint) FindWithContext(ctx context.Context, webhookEndpointID string, options ...WebhookEndpointFindParameters) (*WebhookEndpoint, error) {
+	if s.client == nil {
+		panic("Please use the client.NewWebhookEndpoint() method to create a new WebhookEndpoint object")
+	}
+	if len(options) > 1 {
+		panic("The options parameter should only be provided once.")
+	}
+
+	opt := WebhookEndpointFindParameters{}
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	if opt.Options == nil {
+		opt.Options = &Options{}
+	}
+	s.Prefill(opt.WebhookEndpoint)
+
+	type Response struct {
+		WebhookEndpoint *WebhookEndpoint `json:"webhook_endpoint"`
+		HasMore         bool             `json:"has_more"`
+		Success         bool             `json:"success"`
+		Message         string           `json:"message"`
+		Code            string           `json:"error_type"`
+	}
+
+	data := struct {
+		*Options
+	}{
+		Options: opt.Options,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	path := "/webhook-endpoints/" + url.QueryEscape(webhookEndpointID) + ""
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		s.client.baseURL()+path,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	setupRequest(s.client, opt.Options, req)
+
+	res, err := s.client.do(req, Call{
+		Resource:   "WebhookEndpoint",
+		Method:     "Find",
+		ResourceID: webhookEndpointID,
+		Options:    opt.Options,
+	})
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	payload := &Response{}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
+	if err != nil {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+
+	if !payload.Success {
+		erri := errors.NewFromHTTPResponse(res, resBody)
+
+		return nil, erri
+	}
+
+	payload.WebhookEndpoint.SetClient(s.client)
+	return payload.WebhookEndpoint, nil
+}
+
+// WebhookEndpointSaveParameters is the structure representing the
+// additional parameters used to call WebhookEndpoint.Save
+type WebhookEndpointSaveParameters struct {
+	*Options
+	*WebhookEndpoint
+}
+
+// Save allows you to save the updated webhook endpoint attributes.
+func (s WebhookEndpoint) Save(options ...WebhookEndpointSaveParameters) (*WebhookEndpoint, error) {
+	return s.SaveWithContext(context.Background(), options...)
+}
+
+// SaveWithContext allows you to save the updated webhook endpoint attributes.
+func (s WebhookEndpoint) SaveWithContext(ctx context.Context, options ...WebhookEndpointSaveParameters) (*WebhookEndpoint, error) {
+	if s.client == nil {
+		panic("Please use the client.NewWebhookEndpoint() method to create a new WebhookEndpoint object")
+	}
+	if len(options) > 1 {
+		panic("The options parameter should only be provided once.")
+	}
+
+	opt := WebhookEndpointSaveParameters{}
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	if opt.Options == nil {
+		opt.Options = &Options{}
+	}
+	s.Prefill(opt.WebhookEndpoint)
+	if err := s.client.validate(Call{
+		Resource:   "WebhookEndpoint",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	}, &s); err != nil {
+		return nil, err
+	}
+
+	type Response struct {
+		WebhookEndpoint *WebhookEndpoint `json:"webhook_endpoint"`
+		HasMore         bool             `json:"has_more"`
+		Success         bool             `json:"success"`
+		Message         string           `json:"message"`
+		Code            string           `json:"error_type"`
+	}
+
+	data := struct {
+		*Options
+		URL             interface{} `json:"url"`
+		EventsWhitelist interface{} `json:"events_whitelist"`
+	}{
+		Options:         opt.Options,
+		URL:             s.URL,
+		EventsWhitelist: s.EventsWhitelist,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.New(err, "", "")
+	}
+
+	path := "/webhook-endpoints/" + url.QueryEscape(*s.ID) + ""
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"PUT",
+		s.client.baseURL()+path,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	setupRequest(s.client, opt.Options, req)
+
+	res, err := s.client.do(req, Call{
+		Resource:   "WebhookEndpoint",
+		Method:     "Save",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	payload := &Response{}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
+	if err != nil {
+		return nil, errors.NewFromHTTPResponse(res, resBody)
+	}
+
+	if !payload.Success {
+		erri := errors.NewFromHTTPResponse(res, resBody)
+
+		return nil, erri
+	}
+
+	payload.WebhookEndpoint.SetClient(s.client)
+	return payload.WebhookEndpoint, nil
+}
+
+// WebhookEndpointDeleteParameters is the structure representing the
+// additional parameters used to call WebhookEndpoint.Delete
+type WebhookEndpointDeleteParameters struct {
+	*Options
+	*WebhookEndpoint
+}
+
+// Delete allows you to delete the webhook endpoint.
+func (s WebhookEndpoint) Delete(options ...WebhookEndpointDeleteParameters) error {
+	return s.DeleteWithContext(context.Background(), options...)
+}
+
+// DeleteWithContext allows you to delete the webhook endpoint.
+func (s WebhookEndpoint) DeleteWithContext(ctx context.Context, options ...WebhookEndpointDeleteParameters) error {
+	if s.client == nil {
+		panic("Please use the client.NewWebhookEndpoint() method to create a new WebhookEndpoint object")
+	}
+	if len(options) > 1 {
+		panic("The options parameter should only be provided once.")
+	}
+
+	opt := WebhookEndpointDeleteParameters{}
+	if len(options) == 1 {
+		opt = options[0]
+	}
+	if opt.Options == nil {
+		opt.Options = &Options{}
+	}
+	s.Prefill(opt.WebhookEndpoint)
+
+	type Response struct {
+		HasMore bool   `json:"has_more"`
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+		Code    string `json:"error_type"`
+	}
+
+	data := struct {
+		*Options
+	}{
+		Options: opt.Options,
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return errors.New(err, "", "")
+	}
+
+	path := "/webhook-endpoints/" + url.QueryEscape(*s.ID) + ""
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"DELETE",
+		s.client.baseURL()+path,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	setupRequest(s.client, opt.Options, req)
+
+	res, err := s.client.do(req, Call{
+		Resource:   "WebhookEndpoint",
+		Method:     "Delete",
+		ResourceID: *s.ID,
+		Options:    opt.Options,
+	})
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	payload := &Response{}
+	defer res.Body.Close()
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.NewNetworkError(err)
+	}
+	if res.StatusCode >= 400 {
+		return errors.NewFromHTTPResponse(res, resBody)
+	}
+	err = json.Unmarshal(resBody, payload)
+	if err != nil {
+		return errors.NewFromHTTPResponse(res, resBody)
+	}
+
+	if !payload.Success {
+		erri := errors.NewFromHTTPResponse(res, resBody)
+
+		return erri
+	}
+
+	return nil
+}
+
 // dummyWebhookEndpoint is a dummy function that's only
 // here because some files need specific packages and some don't.
 // It's easier to include it for every file. In case you couldn't
@@ -0,0 +1,305 @@
+package webhooks
+
+import (
+	"bufio"
+	"container/list"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/processout.v4"
+)
+
+// Store keeps track of the events that were already processed, so that the
+// webhooks ProcessOut sends again for the same event aren't applied twice
+type Store interface {
+	// Processed returns whether or not the event with the given ID was
+	// already processed
+	Processed(ctx context.Context, eventID string) (bool, error)
+	// MarkProcessed records that the event with the given ID was processed
+	MarkProcessed(ctx context.Context, eventID string) error
+}
+
+// Deduplicate wraps the handler so that the events already recorded in the
+// store are skipped. Events are marked as processed only once the handler
+// succeeded, so that failed events are handled again when ProcessOut
+// retries them. Concurrent deliveries of the same event are rejected with an
+// error while the first one is being handled
+func Deduplicate(s Store, h HandlerFunc) HandlerFunc {
+	var mu sync.Mutex
+	inFlight := map[string]bool{}
+
+	return func(ctx context.Context, ev *processout.Event) error {
+		id := ev.GetID()
+		if id == "" {
+			return h(ctx, ev)
+		}
+
+		mu.Lock()
+		if inFlight[id] {
+			mu.Unlock()
+			return fmt.Errorf("webhooks: the event %s is already being processed", id)
+		}
+		inFlight[id] = true
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			delete(inFlight, id)
+			mu.Unlock()
+		}()
+
+		done, err := s.Processed(ctx, id)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if err := h(ctx, ev); err != nil {
+			return err
+		}
+		return s.MarkProcessed(ctx, id)
+	}
+}
+
+// MemoryStore is an in-memory LRU Store keeping the most recently used
+// events, up to a maximum number of events and for a limited time. When full,
+// the event least recently marked or looked up is evicted first, while the
+// retention always counts from when the event was marked as processed
+type MemoryStore struct {
+	capacity  int
+	retention time.Duration
+	now       func() time.Time
+
+	mu sync.Mutex
+	// order holds the entries from the most to the least recently used, and
+	// marked from the most to the least recently marked as processed
+	order   *list.List
+	marked  *list.List
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	id     string
+	at     time.Time
+	marked *list.Element
+}
+
+// NewMemoryStore creates a new MemoryStore keeping up to capacity events,
+// each for the given retention. A capacity or retention of 0 or less means
+// no limit
+func NewMemoryStore(capacity int, retention time.Duration) *MemoryStore {
+	return &MemoryStore{
+		capacity:  capacity,
+		retention: retention,
+		now:       time.Now,
+		order:     list.New(),
+		marked:    list.New(),
+		entries:   map[string]*list.Element{},
+	}
+}
+
+// Processed implements Store
+func (s *MemoryStore) Processed(ctx context.Context, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire()
+	e, ok := s.entries[eventID]
+	if ok {
+		s.order.MoveToFront(e)
+	}
+	return ok, nil
+}
+
+// MarkProcessed implements Store
+func (s *MemoryStore) MarkProcessed(ctx context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.entries[eventID]; ok {
+		s.remove(e)
+	}
+	entry := &memoryEntry{id: eventID, at: s.now()}
+	entry.marked = s.marked.PushFront(entry)
+	s.entries[eventID] = s.order.PushFront(entry)
+
+	for s.capacity > 0 && s.order.Len() > s.capacity {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+// expire removes the entries older than the retention
+func (s *MemoryStore) expire() {
+	if s.retention <= 0 {
+		return
+	}
+
+	limit := s.now().Add(-s.retention)
+	for e := s.marked.Back(); e != nil && e.Value.(*memoryEntry).at.Before(limit); e = s.marked.Back() {
+		s.remove(s.entries[e.Value.(*memoryEntry).id])
+	}
+}
+
+func (s *MemoryStore) remove(e *list.Element) {
+	entry := e.Value.(*memoryEntry)
+	s.order.Remove(e)
+	s.marked.Remove(entry.marked)
+	delete(s.entries, entry.id)
+}
+
+// fileStoreCompaction is the minimum number of lines of the file of a
+// FileStore before it is compacted
+const fileStoreCompaction = 1024
+
+// FileStore is a Store persisting the processed events in a file, so that
+// they are remembered across restarts. Events are appended to the file,
+// which is compacted when opened and whenever it holds twice as many lines
+// as events retained after the last compaction, dropping the events older
+// than the retention. Without retention, the store grows without bound
+type FileStore struct {
+	retention time.Duration
+	path      string
+
+	mu      sync.Mutex
+	file    *os.File
+	entries map[string]time.Time
+	// lines is the number of lines of the file, and compacted the number of
+	// events it held after the last compaction
+	lines     int
+	compacted int
+}
+
+// OpenFileStore opens, or creates, the FileStore stored at the given path.
+// A retention of 0 or less keeps the events forever
+func OpenFileStore(path string, retention time.Duration) (*FileStore, error) {
+	s := &FileStore{
+		retention: retention,
+		path:      path,
+		entries:   map[string]time.Time{},
+	}
+
+	if f, err := os.Open(path); err == nil {
+		sc := bufio.NewScanner(f)
+		for sc.Scan() {
+			parts := strings.SplitN(sc.Text(), " ", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			ts, err := strconv.ParseInt(parts[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			s.entries[parts[1]] = time.Unix(ts, 0)
+		}
+		err = sc.Err()
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// compact drops the expired events and rewrites the file with the remaining
+// ones, so that it doesn't grow forever
+func (s *FileStore) compact() error {
+	if s.retention > 0 {
+		limit := time.Now().Add(-s.retention)
+		for id, at := range s.entries {
+			if at.Before(limit) {
+				delete(s.entries, id)
+			}
+		}
+	}
+
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for id, at := range s.entries {
+		fmt.Fprintf(w, "%d %s\n", at.Unix(), id)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if s.file != nil {
+		s.file.Close()
+	}
+	s.file = file
+	s.lines = len(s.entries)
+	s.compacted = len(s.entries)
+	return nil
+}
+
+// Processed implements Store
+func (s *FileStore) Processed(ctx context.Context, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	at, ok := s.entries[eventID]
+	if ok && s.retention > 0 && time.Since(at) > s.retention {
+		return false, nil
+	}
+	return ok, nil
+}
+
+// MarkProcessed implements Store. The event is synced to disk before
+// returning, and the file compacted if needed
+func (s *FileStore) MarkProcessed(ctx context.Context, eventID string) error {
+	if strings.ContainsAny(eventID, " \n") {
+		return fmt.Errorf("webhooks: invalid event ID %q", eventID)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if _, err := fmt.Fprintf(s.file, "%d %s\n", now.Unix(), eventID); err != nil {
+		return err
+	}
+	if err := s.file.Sync(); err != nil {
+		return err
+	}
+	s.entries[eventID] = now
+	s.lines++
+
+	if s.lines >= fileStoreCompaction && s.lines >= 2*s.compacted {
+		return s.compact()
+	}
+	return nil
+}
+
+// Close closes the file backing the store
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
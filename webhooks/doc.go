@@ -0,0 +1,15 @@
+// Package webhooks helps receiving the webhooks sent by ProcessOut. It
+// verifies their signature using the project secret key and parses them
+// into events:
+//
+//	v := webhooks.NewVerifier("<project-secret>")
+//	ev, err := v.VerifyRequest(r)
+//	if err != nil {
+//		http.Error(w, err.Error(), http.StatusBadRequest)
+//		return
+//	}
+//	fmt.Println(*ev.Name)
+//
+// Events missed while the consumer was down can be fed again to the same
+// handlers with Replay.
+package webhooks
@@ -0,0 +1,224 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/processout.v4"
+)
+
+// ReplayOptions selects the events replayed by Replay
+type ReplayOptions struct {
+	// Names restricts the replay to the events with the given names, such as
+	// transaction.captured. A name without a dot, such as transaction,
+	// matches all the events of that resource. All the events are replayed
+	// when empty
+	Names []string
+	// Since and Until restrict the replay to the events fired within the
+	// window, Since included and Until excluded. A zero value leaves the
+	// window open on that side
+	Since time.Time
+	Until time.Time
+	// Seen returns whether or not the event was already processed, in which
+	// case it is skipped. SeenIn can be used to skip the events recorded in
+	// a Store
+	Seen func(ctx context.Context, ev *processout.Event) (bool, error)
+	// Undelivered restricts the replay to the events for which none of the
+	// webhooks sent by ProcessOut was delivered
+	Undelivered bool
+	// PageSize is the number of events fetched per request. The API default
+	// is used when 0
+	PageSize uint64
+}
+
+// ReplayFailure is an event whose handler failed during a replay
+type ReplayFailure struct {
+	Event *processout.Event
+	Err   error
+}
+
+// Error implements the error interface
+func (f ReplayFailure) Error() string {
+	return fmt.Sprintf("webhooks: the replay of the event %s failed: %s", f.Event.GetID(), f.Err.Error())
+}
+
+// Unwrap returns the error returned by the handler
+func (f ReplayFailure) Unwrap() error {
+	return f.Err
+}
+
+// ReplayResult reports the outcome of a replay
+type ReplayResult struct {
+	// Replayed is the number of events successfully handled
+	Replayed int
+	// Skipped is the number of events matching the filters that were
+	// skipped because they were already seen or delivered
+	Skipped int
+	// Failures lists the events whose handler failed
+	Failures []ReplayFailure
+}
+
+// SeenIn returns a Seen predicate skipping the events already recorded as
+// processed in the store
+func SeenIn(s Store) func(ctx context.Context, ev *processout.Event) (bool, error) {
+	return func(ctx context.Context, ev *processout.Event) (bool, error) {
+		return s.Processed(ctx, ev.GetID())
+	}
+}
+
+// Replay lists the events of the project matching the options and feeds
+// them to the handler, so that the webhooks missed while the consumer was
+// down can be caught up. Events are replayed in the order they were fired.
+// As the API lists the most recent events first, the events are listed down
+// to the start of the window and then streamed back page by page from there,
+// so that the window doesn't have to be held in memory, at the cost of
+// fetching its pages twice. Without Since, the whole history is listed once
+// and the matching events are held in memory to be replayed in reverse. The
+// events without firing date are replayed at their position in the listing.
+// The failures of the handler don't stop the replay and are reported in the
+// result, whereas the errors fetching the events abort it and are returned
+// along with the events replayed so far
+func Replay(ctx context.Context, c *processout.ProcessOut, h HandlerFunc, opts ReplayOptions) (*ReplayResult, error) {
+	res := &ReplayResult{}
+
+	it, err := c.NewEvent().AllTypedWithContext(ctx, processout.EventAllParameters{
+		Options: &processout.Options{Limit: opts.PageSize},
+	})
+	if err != nil {
+		return res, err
+	}
+
+	if opts.Since.IsZero() {
+		events := []*processout.Event{}
+		for it.NextWithContext(ctx) {
+			if ev := it.Current(); beforeUntil(ev, opts) && replayName(opts.Names, ev) {
+				events = append(events, ev)
+			}
+		}
+		if err := it.Error(); err != nil {
+			return res, err
+		}
+
+		for i := len(events) - 1; i >= 0; i-- {
+			if err := ctx.Err(); err != nil {
+				return res, err
+			}
+			if err := replayOne(ctx, h, events[i], opts, res); err != nil {
+				return res, err
+			}
+		}
+		return res, nil
+	}
+
+	// Move the iterator to the first event older than the window
+	for it.NextWithContext(ctx) {
+		ev := it.Current()
+		if ev.FiredAt != nil && ev.FiredAt.Before(opts.Since) {
+			break
+		}
+	}
+	if err := it.Error(); err != nil {
+		return res, err
+	}
+
+	for it.PrevWithContext(ctx) {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+
+		ev := it.Current()
+		if !beforeUntil(ev, opts) {
+			// Events are streamed from the oldest one, so all the
+			// following ones are more recent as well
+			break
+		}
+		if !replayName(opts.Names, ev) {
+			continue
+		}
+		if err := replayOne(ctx, h, ev, opts, res); err != nil {
+			return res, err
+		}
+	}
+
+	return res, it.Error()
+}
+
+// beforeUntil returns whether or not the event was fired before the end of
+// the window
+func beforeUntil(ev *processout.Event, opts ReplayOptions) bool {
+	return ev.FiredAt == nil || opts.Until.IsZero() || ev.FiredAt.Before(opts.Until)
+}
+
+// replayOne replays the event unless it is skipped, and records the outcome
+// in the result. Only the errors checking whether the event is skipped are
+// returned
+func replayOne(ctx context.Context, h HandlerFunc, ev *processout.Event, opts ReplayOptions, res *ReplayResult) error {
+	skip, err := skipReplay(ctx, ev, opts)
+	if err != nil {
+		return err
+	}
+	if skip {
+		res.Skipped++
+		return nil
+	}
+
+	if err := replayEvent(ctx, h, ev); err != nil {
+		res.Failures = append(res.Failures, ReplayFailure{Event: ev, Err: err})
+		return nil
+	}
+	res.Replayed++
+	return nil
+}
+
+func replayName(names []string, ev *processout.Event) bool {
+	if len(names) == 0 {
+		return true
+	}
+	if ev.Name == nil {
+		return false
+	}
+
+	for _, n := range names {
+		if n == *ev.Name || (!strings.Contains(n, ".") && strings.HasPrefix(*ev.Name, n+".")) {
+			return true
+		}
+	}
+	return false
+}
+
+// skipReplay returns whether or not the event was already seen, or
+// delivered when only undelivered events are replayed
+func skipReplay(ctx context.Context, ev *processout.Event, opts ReplayOptions) (bool, error) {
+	if opts.Seen != nil {
+		seen, err := opts.Seen(ctx, ev)
+		if err != nil || seen {
+			return seen, err
+		}
+	}
+	if !opts.Undelivered {
+		return false, nil
+	}
+
+	it, err := ev.FetchWebhooksTypedWithContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	for it.NextWithContext(ctx) {
+		if wh := it.Current(); wh.Status != nil && *wh.Status == "delivered" {
+			return true, nil
+		}
+	}
+	return false, it.Error()
+}
+
+func replayEvent(ctx context.Context, h HandlerFunc, ev *processout.Event) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("webhooks: the handler panicked: %v", rec)
+		}
+	}()
+
+	return h(ctx, ev)
+}
@@ -0,0 +1,211 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"gopkg.in/processout.v4"
+)
+
+// HandlerFunc handles a verified event. Returning an error makes the router
+// answer with an error status so that ProcessOut sends the webhook again
+// later
+type HandlerFunc func(ctx context.Context, ev *processout.Event) error
+
+// Router is an http.Handler verifying the webhooks sent by ProcessOut and
+// dispatching their events to the handlers registered for their name
+type Router struct {
+	// OnError is called with the errors returned by handlers, the panics
+	// they raised and the webhooks that failed verification, if set. The
+	// event is nil when the webhook could not be verified
+	OnError func(r *http.Request, ev *processout.Event, err error)
+
+	verifier *Verifier
+	mu       sync.RWMutex
+	handlers map[string]HandlerFunc
+	fallback HandlerFunc
+}
+
+// NewRouter creates a new Router verifying webhooks with the given verifier
+func NewRouter(v *Verifier) *Router {
+	return &Router{
+		verifier: v,
+		handlers: map[string]HandlerFunc{},
+	}
+}
+
+// On registers the handler called for the events with the given name, such
+// as transaction.captured
+func (r *Router) On(name string, h HandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers[name] = h
+}
+
+// Fallback registers the handler called for the events no handler was
+// registered for. Such events are acknowledged without being handled when
+// no fallback is registered
+func (r *Router) Fallback(h HandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fallback = h
+}
+
+// OnInvoice registers the handler called with the invoice of the events
+// with the given name
+func (r *Router) OnInvoice(name string, h func(context.Context, *processout.Invoice) error) {
+	r.On(name, func(ctx context.Context, ev *processout.Event) error {
+		v, err := ev.Invoice()
+		if err != nil {
+			return err
+		}
+		return h(ctx, v)
+	})
+}
+
+// OnTransaction registers the handler called with the transaction of the
+// events with the given name
+func (r *Router) OnTransaction(name string, h func(context.Context, *processout.Transaction) error) {
+	r.On(name, func(ctx context.Context, ev *processout.Event) error {
+		v, err := ev.Transaction()
+		if err != nil {
+			return err
+		}
+		return h(ctx, v)
+	})
+}
+
+// OnSubscription registers the handler called with the subscription of the
+// events with the given name
+func (r *Router) OnSubscription(name string, h func(context.Context, *processout.Subscription) error) {
+	r.On(name, func(ctx context.Context, ev *processout.Event) error {
+		v, err := ev.Subscription()
+		if err != nil {
+			return err
+		}
+		return h(ctx, v)
+	})
+}
+
+// OnRefund registers the handler called with the refund of the events with
+// the given name
+func (r *Router) OnRefund(name string, h func(context.Context, *processout.Refund) error) {
+	r.On(name, func(ctx context.Context, ev *processout.Event) error {
+		v, err := ev.Refund()
+		if err != nil {
+			return err
+		}
+		return h(ctx, v)
+	})
+}
+
+// OnPayout registers the handler called with the payout of the events with
+// the given name
+func (r *Router) OnPayout(name string, h func(context.Context, *processout.Payout) error) {
+	r.On(name, func(ctx context.Context, ev *processout.Event) error {
+		v, err := ev.Payout()
+		if err != nil {
+			return err
+		}
+		return h(ctx, v)
+	})
+}
+
+// OnCustomer registers the handler called with the customer of the events
+// with the given name
+func (r *Router) OnCustomer(name string, h func(context.Context, *processout.Customer) error) {
+	r.On(name, func(ctx context.Context, ev *processout.Event) error {
+		v, err := ev.Customer()
+		if err != nil {
+			return err
+		}
+		return h(ctx, v)
+	})
+}
+
+// OnToken registers the handler called with the token of the events with
+// the given name
+func (r *Router) OnToken(name string, h func(context.Context, *processout.Token) error) {
+	r.On(name, func(ctx context.Context, ev *processout.Event) error {
+		v, err := ev.Token()
+		if err != nil {
+			return err
+		}
+		return h(ctx, v)
+	})
+}
+
+// ServeHTTP implements http.Handler. Webhooks failing verification are
+// rejected with a 400 status, or 413 when their body is too large, and
+// events whose handler failed or panicked with a 500 status so that
+// ProcessOut retries them. Events outside of the
+// verifier Tolerance are reported to OnError and acknowledged without being
+// handled, as redelivering them wouldn't make them any more recent
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ev, err := r.verifier.VerifyRequest(req)
+	if serr, ok := err.(*StaleEventError); ok {
+		r.error(req, serr.Event, err)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err == ErrBodyTooLarge {
+		r.error(req, nil, err)
+		http.Error(w, "webhook too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		r.error(req, nil, err)
+		http.Error(w, "invalid webhook", http.StatusBadRequest)
+		return
+	}
+
+	if err := r.Dispatch(req.Context(), ev); err != nil {
+		r.error(req, ev, err)
+		http.Error(w, "the event could not be handled", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Dispatch calls the handler registered for the given event, recovering
+// from its panics
+func (r *Router) Dispatch(ctx context.Context, ev *processout.Event) (err error) {
+	name := ""
+	if ev.Name != nil {
+		name = *ev.Name
+	}
+
+	r.mu.RLock()
+	h, ok := r.handlers[name]
+	if !ok {
+		h = r.fallback
+	}
+	r.mu.RUnlock()
+	if h == nil {
+		return nil
+	}
+
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("webhooks: the handler of %s panicked: %v", name, rec)
+		}
+	}()
+	return h(ctx, ev)
+}
+
+func (r *Router) error(req *http.Request, ev *processout.Event, err error) {
+	if r.OnError != nil {
+		r.OnError(req, ev, err)
+	}
+}
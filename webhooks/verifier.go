@@ -0,0 +1,156 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"gopkg.in/processout.v4"
+)
+
+var (
+	// SignatureHeader is the header containing the signature of the
+	// webhooks sent by ProcessOut
+	SignatureHeader = "X-ProcessOut-Signature"
+	// DefaultTolerance is the maximum age of the events accepted by
+	// verifiers created with NewVerifier, protecting against the replay of
+	// intercepted webhooks. ProcessOut redelivers the webhooks that failed
+	// with their original firing date, so late redeliveries are rejected
+	// as well
+	DefaultTolerance = 5 * time.Minute
+	// MaxBodySize is the maximum size of the webhook bodies read by
+	// VerifyRequest
+	MaxBodySize int64 = 1 << 20
+
+	// ErrBodyTooLarge is returned by VerifyRequest when the webhook body
+	// is larger than MaxBodySize
+	ErrBodyTooLarge = errors.New("webhooks: the webhook body is too large")
+)
+
+// SignatureError is returned when a webhook could not be verified because
+// its signature is missing or invalid. Such webhooks may have been tampered
+// with and must be rejected
+type SignatureError struct {
+	message string
+}
+
+// Error returns the error message
+func (e *SignatureError) Error() string {
+	return e.message
+}
+
+// StaleEventError is returned when the signature of a webhook is valid but
+// its event was fired outside of the Tolerance of the verifier, or has no
+// firing date
+type StaleEventError struct {
+	// Event is the verified event
+	Event *processout.Event
+
+	message string
+}
+
+// Error returns the error message
+func (e *StaleEventError) Error() string {
+	return e.message
+}
+
+// Verifier verifies the signature of the webhooks sent by ProcessOut and
+// parses them into events
+type Verifier struct {
+	// Tolerance is the maximum difference between the time at which the
+	// event was fired and the time it is verified at. The check is disabled
+	// when Tolerance is 0 or less
+	Tolerance time.Duration
+	// Client is the ProcessOut client attached to the parsed events, if any
+	Client *processout.ProcessOut
+
+	secret string
+	now    func() time.Time
+}
+
+// NewVerifier creates a new Verifier checking signatures against the given
+// project secret key
+func NewVerifier(projectSecret string) *Verifier {
+	return &Verifier{
+		Tolerance: DefaultTolerance,
+		secret:    projectSecret,
+		now:       time.Now,
+	}
+}
+
+// Sign returns the signature of the given payload, as sent by ProcessOut in
+// the signature header: the base64 encoded HMAC-SHA256 of the payload keyed
+// with the project secret key
+func (v *Verifier) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(v.secret))
+	mac.Write(payload)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of the given webhook payload and returns the
+// event it contains. A SignatureError is returned if the signature is
+// invalid, and a StaleEventError if the event is outside of the Tolerance
+func (v *Verifier) Verify(payload []byte, signature string) (*processout.Event, error) {
+	signature = strings.TrimSpace(signature)
+	if signature == "" {
+		return nil, &SignatureError{"webhooks: the signature is missing"}
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return nil, &SignatureError{"webhooks: the signature is malformed"}
+	}
+
+	mac := hmac.New(sha256.New, []byte(v.secret))
+	mac.Write(payload)
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return nil, &SignatureError{"webhooks: the signature does not match the payload"}
+	}
+
+	ev := &processout.Event{}
+	if err := json.Unmarshal(payload, ev); err != nil {
+		return nil, err
+	}
+
+	if v.Tolerance > 0 {
+		if ev.FiredAt == nil {
+			return nil, &StaleEventError{ev, "webhooks: the event has no firing date"}
+		}
+		now := time.Now
+		if v.now != nil {
+			now = v.now
+		}
+		d := now().Sub(*ev.FiredAt)
+		if d < 0 {
+			d = -d
+		}
+		if d > v.Tolerance {
+			return nil, &StaleEventError{ev, "webhooks: the event was fired outside of the tolerance window"}
+		}
+	}
+
+	if v.Client != nil {
+		ev.SetClient(v.Client)
+	}
+	return ev, nil
+}
+
+// VerifyRequest reads the body of the webhook request and verifies it
+// against the signature header. ErrBodyTooLarge is returned if the body is
+// larger than MaxBodySize
+func (v *Verifier) VerifyRequest(r *http.Request) (*processout.Event, error) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, MaxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(payload)) > MaxBodySize {
+		return nil, ErrBodyTooLarge
+	}
+
+	return v.Verify(payload, r.Header.Get(SignatureHeader))
+}
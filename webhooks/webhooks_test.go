@@ -0,0 +1,348 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/processout.v4"
+)
+
+func newTestVerifier(now time.Time) *Verifier {
+	v := NewVerifier("key_test")
+	v.now = func() time.Time { return now }
+	return v
+}
+
+func TestVerify(t *testing.T) {
+	firedAt := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	v := newTestVerifier(firedAt.Add(time.Minute))
+	payload := []byte(`{"id":"ev_test","name":"transaction.captured","fired_at":"2020-01-01T12:00:00Z"}`)
+
+	ev, err := v.Verify(payload, v.Sign(payload))
+	if err != nil {
+		t.Fatalf("The webhook should have been verified, but got: %s", err.Error())
+	}
+	if *ev.ID != "ev_test" || *ev.Name != "transaction.captured" {
+		t.Errorf("The event was not parsed correctly")
+	}
+
+	req, _ := http.NewRequest("POST", "https://example.com/webhooks", bytes.NewReader(payload))
+	req.Header.Set(SignatureHeader, v.Sign(payload))
+	if _, err := v.VerifyRequest(req); err != nil {
+		t.Errorf("The webhook request should have been verified, but got: %s", err.Error())
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	firedAt := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	payload := []byte(`{"id":"ev_test","name":"transaction.captured","fired_at":"2020-01-01T12:00:00Z"}`)
+	tampered := []byte(`{"id":"ev_test","name":"transaction.refunded","fired_at":"2020-01-01T12:00:00Z"}`)
+
+	v := newTestVerifier(firedAt)
+	cases := map[string]struct {
+		v         *Verifier
+		payload   []byte
+		signature string
+	}{
+		"missing signature": {v, payload, ""},
+		"malformed":         {v, payload, "not base64!"},
+		"tampered payload":  {v, tampered, v.Sign(payload)},
+		"wrong secret":      {v, payload, NewVerifier("key_other").Sign(payload)},
+	}
+	for name, c := range cases {
+		_, err := c.v.Verify(c.payload, c.signature)
+		if _, ok := err.(*SignatureError); !ok {
+			t.Errorf("%s: a signature error should have been returned, but got %v", name, err)
+		}
+	}
+
+	old := newTestVerifier(firedAt.Add(time.Hour))
+	_, err := old.Verify(payload, v.Sign(payload))
+	if serr, ok := err.(*StaleEventError); !ok || *serr.Event.ID != "ev_test" {
+		t.Errorf("Events older than the default tolerance should be rejected as stale, but got %v", err)
+	}
+	old.Tolerance = 0
+	if _, err := old.Verify(payload, v.Sign(payload)); err != nil {
+		t.Errorf("Old events should be accepted without tolerance, but got %v", err)
+	}
+
+	large := append([]byte(`{"id":"ev_test","padding":"`), bytes.Repeat([]byte("a"), int(MaxBodySize))...)
+	large = append(large, '"', '}')
+	req, _ := http.NewRequest("POST", "https://example.com/webhooks", bytes.NewReader(large))
+	req.Header.Set(SignatureHeader, v.Sign(large))
+	if _, err := v.VerifyRequest(req); err != ErrBodyTooLarge {
+		t.Errorf("Bodies larger than MaxBodySize should be rejected as too large, but got %v", err)
+	}
+}
+
+func TestRouter(t *testing.T) {
+	firedAt := time.Now().UTC().Format(time.RFC3339)
+	v := NewVerifier("key_test")
+	r := NewRouter(v)
+
+	captured := ""
+	r.OnTransaction("transaction.captured", func(ctx context.Context, tr *processout.Transaction) error {
+		captured = *tr.ID
+		return nil
+	})
+	r.On("transaction.failed", func(ctx context.Context, ev *processout.Event) error {
+		return fmt.Errorf("the transaction could not be processed")
+	})
+	r.On("transaction.refunded", func(ctx context.Context, ev *processout.Event) error {
+		panic("boom")
+	})
+
+	send := func(name string, sign bool) int {
+		payload := []byte(fmt.Sprintf(`{"id":"ev_test","name":%q,"fired_at":%q,"data":{"transaction":{"id":"tr_test"}}}`, name, firedAt))
+		req := httptest.NewRequest("POST", "/webhooks", bytes.NewReader(payload))
+		if sign {
+			req.Header.Set(SignatureHeader, v.Sign(payload))
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if c := send("transaction.captured", true); c != http.StatusOK || captured != "tr_test" {
+		t.Errorf("The event should have been handled, got status %d", c)
+	}
+	if c := send("transaction.voided", true); c != http.StatusOK {
+		t.Errorf("Events without handler should be acknowledged, got status %d", c)
+	}
+	if c := send("transaction.failed", true); c != http.StatusInternalServerError {
+		t.Errorf("Handler errors should be answered with a 500, got status %d", c)
+	}
+	if c := send("transaction.refunded", true); c != http.StatusInternalServerError {
+		t.Errorf("Handler panics should be answered with a 500, got status %d", c)
+	}
+	if c := send("transaction.captured", false); c != http.StatusBadRequest {
+		t.Errorf("Unsigned webhooks should be answered with a 400, got status %d", c)
+	}
+
+	var stale error
+	r.OnError = func(r *http.Request, ev *processout.Event, err error) {
+		stale = err
+	}
+	firedAt = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	captured = ""
+	if c := send("transaction.captured", true); c != http.StatusOK || captured != "" {
+		t.Errorf("Stale events should be acknowledged without being handled, got status %d", c)
+	}
+	if _, ok := stale.(*StaleEventError); !ok {
+		t.Errorf("Stale events should be reported as such, got %v", stale)
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events")
+	fs, err := OpenFileStore(path, time.Hour)
+	if err != nil {
+		t.Fatalf("The file store could not be opened: %s", err.Error())
+	}
+
+	stores := map[string]Store{
+		"memory": NewMemoryStore(10, time.Hour),
+		"file":   fs,
+	}
+	for name, s := range stores {
+		calls := 0
+		fail := true
+		h := Deduplicate(s, func(ctx context.Context, ev *processout.Event) error {
+			calls++
+			if fail {
+				return fmt.Errorf("failure")
+			}
+			return nil
+		})
+
+		ev := &processout.Event{ID: processout.String("ev_test")}
+		if err := h(context.Background(), ev); err == nil {
+			t.Errorf("%s: the handler error should have been returned", name)
+		}
+		fail = false
+		for n := 0; n < 2; n++ {
+			if err := h(context.Background(), ev); err != nil {
+				t.Errorf("%s: there shouldn't have been any error, but got %s", name, err.Error())
+			}
+		}
+		if calls != 2 {
+			t.Errorf("%s: the handler should have been called twice, but was called %d times", name, calls)
+		}
+	}
+	fs.Close()
+
+	fs, err = OpenFileStore(path, time.Hour)
+	if err != nil {
+		t.Fatalf("The file store could not be reopened: %s", err.Error())
+	}
+	defer fs.Close()
+	if ok, _ := fs.Processed(context.Background(), "ev_test"); !ok {
+		t.Errorf("The processed event should have been persisted")
+	}
+
+	for n := 0; n < 2*fileStoreCompaction; n++ {
+		fs.MarkProcessed(context.Background(), fmt.Sprintf("ev_%d", n%10))
+	}
+	b, _ := os.ReadFile(path)
+	if lines := bytes.Count(b, []byte("\n")); lines >= fileStoreCompaction {
+		t.Errorf("The file should have been compacted, but has %d lines", lines)
+	}
+}
+
+func TestMemoryStoreCapacity(t *testing.T) {
+	s := NewMemoryStore(2, 0)
+	ctx := context.Background()
+	for _, id := range []string{"ev_1", "ev_2", "ev_3"} {
+		s.MarkProcessed(ctx, id)
+	}
+	if ok, _ := s.Processed(ctx, "ev_1"); ok {
+		t.Errorf("The oldest event should have been evicted")
+	}
+	if ok, _ := s.Processed(ctx, "ev_3"); !ok {
+		t.Errorf("The latest event should have been kept")
+	}
+}
+
+func TestMemoryStoreLRU(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewMemoryStore(2, time.Hour)
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	s.MarkProcessed(ctx, "ev_1")
+	s.MarkProcessed(ctx, "ev_2")
+	if ok, _ := s.Processed(ctx, "ev_1"); !ok {
+		t.Fatalf("The event ev_1 should have been kept")
+	}
+	s.MarkProcessed(ctx, "ev_3")
+	if ok, _ := s.Processed(ctx, "ev_1"); !ok {
+		t.Errorf("The recently looked up event should have survived the eviction")
+	}
+	if ok, _ := s.Processed(ctx, "ev_2"); ok {
+		t.Errorf("The least recently used event should have been evicted")
+	}
+
+	now = now.Add(61 * time.Minute)
+	if ok, _ := s.Processed(ctx, "ev_1"); ok {
+		t.Errorf("The lookups shouldn't have extended the retention of the event")
+	}
+	if len(s.entries) != 0 || s.order.Len() != 0 || s.marked.Len() != 0 {
+		t.Errorf("All the expired events should have been removed")
+	}
+}
+
+func TestReplay(t *testing.T) {
+	at := func(h int) string {
+		return time.Date(2020, 1, 1, h, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	}
+	events := []string{
+		fmt.Sprintf(`{"id":"ev_6","name":"transaction.captured","fired_at":%q}`, at(6)),
+		fmt.Sprintf(`{"id":"ev_5","name":"invoice.created","fired_at":%q}`, at(5)),
+		fmt.Sprintf(`{"id":"ev_4","name":"transaction.refunded","fired_at":%q}`, at(4)),
+		fmt.Sprintf(`{"id":"ev_3","name":"transaction.captured","fired_at":%q}`, at(3)),
+		fmt.Sprintf(`{"id":"ev_2","name":"transaction.failed","fired_at":%q}`, at(2)),
+		fmt.Sprintf(`{"id":"ev_1","name":"transaction.authorized","fired_at":%q}`, at(1)),
+		fmt.Sprintf(`{"id":"ev_0","name":"transaction.captured","fired_at":%q}`, at(0)),
+		fmt.Sprintf(`{"id":"ev_00","name":"transaction.captured","fired_at":%q}`, at(0)),
+	}
+
+	pages := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/webhooks") {
+			status := "failed"
+			if r.URL.Path == "/events/ev_3/webhooks" {
+				status = "delivered"
+			}
+			fmt.Fprintf(w, `{"success":true,"webhooks":[{"id":"wh_1","status":%q}]}`, status)
+			return
+		}
+
+		pages++
+		q := r.URL.Query()
+		from, to := 0, 2
+		for n, ev := range events {
+			if strings.Contains(ev, fmt.Sprintf(`"id":%q`, q.Get("start_after"))) {
+				from, to = n+1, n+3
+			}
+			if strings.Contains(ev, fmt.Sprintf(`"id":%q`, q.Get("end_before"))) {
+				from, to = max(n-2, 0), n
+			}
+		}
+		hasMore := to < len(events)
+		if q.Get("end_before") != "" {
+			hasMore = from > 0
+		}
+		fmt.Fprintf(w, `{"success":true,"has_more":%t,"events":[%s]}`,
+			hasMore, strings.Join(events[from:to], ","))
+	}))
+	defer srv.Close()
+
+	c := processout.New("project-id", "project-secret")
+	c.BaseURL = srv.URL
+
+	seen := NewMemoryStore(0, 0)
+	seen.MarkProcessed(context.Background(), "ev_1")
+
+	handled := []string{}
+	res, err := Replay(context.Background(), c, func(ctx context.Context, ev *processout.Event) error {
+		handled = append(handled, ev.GetID())
+		if *ev.Name == "transaction.failed" {
+			return fmt.Errorf("failure")
+		}
+		return nil
+	}, ReplayOptions{
+		Names:       []string{"transaction"},
+		Since:       time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC),
+		Until:       time.Date(2020, 1, 1, 6, 0, 0, 0, time.UTC),
+		Seen:        SeenIn(seen),
+		Undelivered: true,
+		PageSize:    2,
+	})
+	if err != nil {
+		t.Fatalf("The events could not be replayed: %s", err.Error())
+	}
+
+	if strings.Join(handled, ",") != "ev_2,ev_4" {
+		t.Errorf("The events ev_2 and ev_4 should have been handled in order, got %v", handled)
+	}
+	if res.Replayed != 1 || res.Skipped != 2 {
+		t.Errorf("1 event should have been replayed and 2 skipped, got %d and %d", res.Replayed, res.Skipped)
+	}
+	if len(res.Failures) != 1 || res.Failures[0].Event.GetID() != "ev_2" {
+		t.Errorf("The failure of ev_2 should have been reported, got %v", res.Failures)
+	}
+	if pages != 7 {
+		t.Errorf("The events should have been listed down to the window and back in 7 pages, got %d", pages)
+	}
+
+	pages = 0
+	handled = []string{}
+	res, err = Replay(context.Background(), c, func(ctx context.Context, ev *processout.Event) error {
+		handled = append(handled, ev.GetID())
+		return nil
+	}, ReplayOptions{
+		Names:    []string{"transaction"},
+		Until:    time.Date(2020, 1, 1, 4, 0, 0, 0, time.UTC),
+		PageSize: 2,
+	})
+	if err != nil {
+		t.Fatalf("The events could not be replayed: %s", err.Error())
+	}
+	if strings.Join(handled, ",") != "ev_00,ev_0,ev_1,ev_2,ev_3" {
+		t.Errorf("The events up to ev_3 should have been handled in order, got %v", handled)
+	}
+	if res.Replayed != 5 {
+		t.Errorf("5 events should have been replayed, got %d", res.Replayed)
+	}
+	if pages != 4 {
+		t.Errorf("Without Since, the events should have been listed once in 4 pages, got %d", pages)
+	}
+}